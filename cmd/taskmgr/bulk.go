@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/config"
+	yamlstore "github.com/hiroto-aibara/secretary-ai/internal/infra/yaml"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+const bulkUsage = "usage: taskmgr bulk <board-id> [file]"
+
+// bulkRequest is the body of POST /api/boards/{id}/cards/bulk.
+type bulkRequest struct {
+	Operations []usecase.BulkOperation `json:"operations"`
+}
+
+type bulkOutput struct {
+	Results []usecase.BulkResult `json:"results"`
+}
+
+// runBulk applies a bulk request, read from file or from stdin when file is
+// "-" or missing, to the cards of the configured storage and prints the
+// per-card results as JSON. It writes the storage directly, as an editor
+// would; a running server picks YAML changes up through its watcher. It
+// returns the exit code.
+func runBulk(basePath string, cfg *config.Config, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) < 1 || len(args) > 2 {
+		fmt.Fprintln(stderr, bulkUsage)
+		return 2
+	}
+	boardID := args[0]
+
+	in := stdin
+	if len(args) == 2 && args[1] != "-" {
+		f, err := os.Open(args[1])
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		defer f.Close()
+		in = f
+	}
+
+	var req bulkRequest
+	if err := json.NewDecoder(in).Decode(&req); err != nil {
+		fmt.Fprintln(stderr, "invalid request:", err)
+		return 1
+	}
+
+	repos, err := openStorage(cfg.Storage.Driver, yamlstore.NewStore(basePath), cfg.Storage.Path)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	defer repos.close()
+
+	cardUC := usecase.NewCardUseCase(repos.cards, repos.boards)
+	results, err := cardUC.Bulk(context.Background(), boardID, req.Operations)
+	if results != nil {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if encErr := enc.Encode(bulkOutput{Results: results}); encErr != nil {
+			fmt.Fprintln(stderr, encErr)
+			return 1
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/config"
+	yamlstore "github.com/hiroto-aibara/secretary-ai/internal/infra/yaml"
+)
+
+// runFixIDs gives new IDs to cards that share an ID with another card of
+// their board, as a git merge can leave them, on the given boards or on all
+// of them. With --dry-run it only prints what it would change. Conflicted
+// card files it cannot split are listed and make the exit code 1. Cards
+// whose parent or blocked_by names a reassigned ID are listed for the user
+// to check, since either card may have been meant.
+func runFixIDs(basePath string, cfg *config.Config, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("fix-ids", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	dryRun := fs.Bool("dry-run", false, "report what would change without writing anything")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if cfg.Storage.Driver != config.DriverYAML {
+		fmt.Fprintf(stderr, "fix-ids: only YAML storage can hold duplicate card IDs, not %s\n", cfg.Storage.Driver)
+		return 2
+	}
+
+	ctx := context.Background()
+	store := yamlstore.NewStore(basePath)
+	boardIDs := fs.Args()
+	if len(boardIDs) == 0 {
+		boards, err := store.List(ctx)
+		if err != nil {
+			fmt.Fprintln(stderr, "fix-ids:", err)
+			return 1
+		}
+		for _, b := range boards {
+			boardIDs = append(boardIDs, b.ID)
+		}
+	}
+
+	var reassigned []yamlstore.IDReassignment
+	unresolved := 0
+	for _, boardID := range boardIDs {
+		result, err := store.ResolveIDConflicts(ctx, boardID, *dryRun)
+		if err != nil {
+			fmt.Fprintf(stderr, "fix-ids: %s: %v\n", boardID, err)
+			return 1
+		}
+		for _, c := range result.Reassignments {
+			fmt.Fprintf(stdout, "%s: %s -> %s (%s)\n", c.BoardID, c.OldID, c.NewID, c.File)
+		}
+		for _, file := range result.Unresolved {
+			fmt.Fprintf(stderr, "fix-ids: %s: conflict is not two added cards, merge it by hand: %s\n", boardID, file)
+		}
+		reassigned = append(reassigned, result.Reassignments...)
+		unresolved += len(result.Unresolved)
+	}
+
+	// A reference to an old ID may have meant either card that had it.
+	refs, err := store.FindIDReferences(ctx, reassigned)
+	if err != nil {
+		fmt.Fprintln(stderr, "fix-ids:", err)
+		return 1
+	}
+	newIDs := make(map[domain.CardRef]string, len(reassigned))
+	for _, r := range reassigned {
+		newIDs[domain.CardRef{Board: r.BoardID, ID: r.OldID}] = r.NewID
+	}
+	for _, r := range refs {
+		fmt.Fprintf(stdout, "check %s/%s: %s %s/%s may mean %s\n", r.BoardID, r.CardID, r.Field, r.Ref.Board, r.Ref.ID, newIDs[r.Ref])
+	}
+
+	total := len(reassigned)
+	switch {
+	case total == 0 && unresolved == 0:
+		fmt.Fprintln(stdout, "no duplicate card IDs")
+	case *dryRun:
+		fmt.Fprintf(stdout, "dry run: %d cards would get new IDs\n", total)
+	default:
+		fmt.Fprintf(stdout, "gave %d cards new IDs; %d references to the old IDs were left as they are\n", total, len(refs))
+	}
+	if unresolved > 0 {
+		return 1
+	}
+	return 0
+}
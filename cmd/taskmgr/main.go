@@ -14,28 +14,107 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 
 	"github.com/hiroto-aibara/secretary-ai/internal/handler"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/config"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/scheduler"
 	"github.com/hiroto-aibara/secretary-ai/internal/infra/watcher"
 	yamlstore "github.com/hiroto-aibara/secretary-ai/internal/infra/yaml"
 	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
 	"github.com/hiroto-aibara/secretary-ai/web"
 )
 
+// maxAttachmentSize is the largest file accepted as a card attachment.
+const maxAttachmentSize = 10 << 20
+
+// recurringInterval is how often due recurring cards are materialized.
+const recurringInterval = time.Minute
+
+// trashRetention is how long deleted boards and cards can be restored.
+const trashRetention = 30 * 24 * time.Hour
+
+// trashInterval is how often expired trash items are purged.
+const trashInterval = time.Hour
+
 func main() {
 	basePath := ".tasks"
 
+	cfg, err := config.Load(basePath)
+	if err != nil {
+		slog.Error("config load failed", "error", err)
+		os.Exit(1)
+	}
+	if err := yamlstore.CheckSchemaVersion(cfg.SchemaVersion); err != nil {
+		slog.Error("refusing to run", "error", err)
+		os.Exit(1)
+	}
+
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "bulk":
+			os.Exit(runBulk(basePath, cfg, os.Args[2:], os.Stdin, os.Stdout, os.Stderr))
+		case "migrate":
+			os.Exit(runMigrate(basePath, cfg, os.Args[2:], os.Stdout, os.Stderr))
+		case "upgrade":
+			os.Exit(runUpgrade(basePath, cfg, os.Args[2:], os.Stdout, os.Stderr))
+		case "fix-ids":
+			os.Exit(runFixIDs(basePath, cfg, os.Args[2:], os.Stdout, os.Stderr))
+		}
+	}
+
 	// infra
 	store := yamlstore.NewStore(basePath)
-	cardRepo := yamlstore.NewCardRepositoryAdapter(store)
+	if err := upgradeSchema(context.Background(), basePath, cfg, store); err != nil {
+		slog.Error("schema upgrade failed", "error", err)
+		os.Exit(1)
+	}
+	repos, err := openStorage(cfg.Storage.Driver, store, cfg.Storage.Path)
+	if err != nil {
+		slog.Error("storage open failed", "driver", cfg.Storage.Driver, "error", err)
+		os.Exit(1)
+	}
+	defer repos.close()
 	hub := handler.NewHub()
+	notifier := watcher.NewNotifier(hub)
+	repos.notifyWrites(notifier)
+	boardRepo := repos.boards
+	commentRepo := yamlstore.NewCommentRepositoryAdapter(store)
+	attachmentRepo := yamlstore.NewAttachmentRepositoryAdapter(store)
+	recurringRepo := yamlstore.NewRecurringRepositoryAdapter(store)
+	templateRepo := yamlstore.NewTemplateRepositoryAdapter(store)
+	viewRepo := yamlstore.NewViewRepositoryAdapter(store)
+	boardTemplateRepo := yamlstore.NewBoardTemplateRepositoryAdapter(store)
 	w := watcher.New(hub, basePath)
+	// The store drops cached cards first, so later listeners read the new files.
+	w.AddListener(store)
 
 	// usecase
-	boardUC := usecase.NewBoardUseCase(store)
-	cardUC := usecase.NewCardUseCase(cardRepo, store)
+	searchUC := usecase.NewSearchUseCase(repos.cards, boardRepo)
+	cardRepo := searchUC.IndexingRepository(repos.cards)
+	w.AddListener(searchUC)
+	// Writes the watcher cannot see, such as board deletes and trash restores
+	// under SQLite, reach the index through the notifier.
+	notifier.AddListener(searchUC)
+	boardUC := usecase.NewBoardUseCase(boardRepo)
+	boardTemplateUC := usecase.NewBoardTemplateUseCase(boardTemplateRepo, boardUC)
+	boardCloneUC := usecase.NewBoardCloneUseCase(boardRepo, cardRepo, templateRepo, viewRepo)
+	cardUC := usecase.NewCardUseCase(cardRepo, boardRepo)
+	commentUC := usecase.NewCommentUseCase(commentRepo, cardRepo)
+	attachmentUC := usecase.NewAttachmentUseCase(attachmentRepo, cardUC, maxAttachmentSize)
+	templateUC := usecase.NewTemplateUseCase(templateRepo, boardRepo, cardUC)
+	recurringUC := usecase.NewRecurringUseCase(recurringRepo, boardRepo, cardUC)
+	viewUC := usecase.NewViewUseCase(viewRepo, boardRepo, cardUC)
+	trashUC := usecase.NewTrashUseCase(repos.trash, trashRetention)
+	sched := scheduler.New(recurringUC, recurringInterval)
+	trashSched := scheduler.New(trashUC, trashInterval)
 
 	// handler
-	boardH := handler.NewBoardHandler(boardUC)
-	cardH := handler.NewCardHandler(cardUC)
+	boardH := handler.NewBoardHandler(boardUC, boardTemplateUC, boardCloneUC)
+	cardH := handler.NewCardHandler(cardUC, templateUC)
+	commentH := handler.NewCommentHandler(commentUC)
+	attachmentH := handler.NewAttachmentHandler(attachmentUC)
+	searchH := handler.NewSearchHandler(searchUC)
+	recurringH := handler.NewRecurringHandler(recurringUC)
+	viewH := handler.NewViewHandler(viewUC)
+	trashH := handler.NewTrashHandler(trashUC)
 	wsH := handler.NewWSHandler(hub)
 
 	// router
@@ -45,11 +124,22 @@ func main() {
 
 	boardH.Register(r)
 	cardH.Register(r)
+	commentH.Register(r)
+	attachmentH.Register(r)
+	searchH.Register(r)
+	recurringH.Register(r)
+	viewH.Register(r)
+	trashH.Register(r)
 	wsH.Register(r)
 
 	// static files (embedded frontend)
 	r.Get("/*", web.SPAHandler())
 
+	// build search index
+	if err := searchUC.Rebuild(context.Background()); err != nil {
+		slog.Error("search index build failed", "error", err)
+	}
+
 	// start watcher
 	watchCtx, watchCancel := context.WithCancel(context.Background())
 	defer watchCancel()
@@ -59,6 +149,18 @@ func main() {
 		}
 	}()
 
+	// start schedulers
+	go func() {
+		if err := sched.Start(watchCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("scheduler failed", "error", err)
+		}
+	}()
+	go func() {
+		if err := trashSched.Start(watchCtx); err != nil && !errors.Is(err, context.Canceled) {
+			slog.Error("trash scheduler failed", "error", err)
+		}
+	}()
+
 	// server
 	srv := &http.Server{
 		Addr:    ":8080",
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/config"
+	yamlstore "github.com/hiroto-aibara/secretary-ai/internal/infra/yaml"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+// runMigrate copies boards, cards, ID counters and the trash between
+// storage drivers, keeping IDs and timestamps. It does not change config.yaml; the caller switches the
+// driver once the copy has succeeded. It returns the exit code.
+func runMigrate(basePath string, cfg *config.Config, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	from := fs.String("from", "", "storage to copy from (yaml or sqlite)")
+	to := fs.String("to", "", "storage to copy to (yaml or sqlite)")
+	db := fs.String("db", cfg.Storage.Path, "SQLite database file")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	fromDriver, toDriver := config.Driver(*from), config.Driver(*to)
+	for _, d := range []config.Driver{fromDriver, toDriver} {
+		if err := d.Validate(); err != nil {
+			fmt.Fprintln(stderr, err)
+			fs.Usage()
+			return 2
+		}
+	}
+	if fromDriver == toDriver {
+		fmt.Fprintln(stderr, "--from and --to must differ")
+		return 2
+	}
+
+	store := yamlstore.NewStore(basePath)
+	src, err := openStorage(fromDriver, store, *db)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	defer src.close()
+	dst, err := openStorage(toDriver, store, *db)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	defer dst.close()
+
+	copyUC := usecase.NewStorageCopyUseCase(
+		usecase.Storage{Boards: src.boards, Cards: src.cards, Trash: src.trashCopy, Counters: src.counters},
+		usecase.Storage{Boards: dst.boards, Cards: dst.cards, Trash: dst.trashCopy, Counters: dst.counters},
+	)
+	result, err := copyUC.Copy(context.Background())
+	if err != nil {
+		fmt.Fprintln(stderr, "migrate:", err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "copied %d boards, %d cards and %d trash items from %s to %s\n",
+		result.Boards, result.Cards, result.TrashItems, fromDriver, toDriver)
+	fmt.Fprintf(stdout, "set storage.driver to %s in %s to use them\n", toDriver, config.FileName)
+	return 0
+}
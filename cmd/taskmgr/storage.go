@@ -0,0 +1,61 @@
+package main
+
+import (
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/config"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/sqlite"
+	yamlstore "github.com/hiroto-aibara/secretary-ai/internal/infra/yaml"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+// storage holds the board, card and trash repositories of one driver.
+type storage struct {
+	boards    domain.BoardRepository
+	cards     domain.CardRepository
+	trash     domain.TrashRepository
+	trashCopy domain.TrashCopier
+	counters  domain.IDCounterRepository
+	// watched is whether the file watcher sees the driver's writes.
+	watched bool
+	close   func() error
+}
+
+// openStorage opens the repositories for driver. The YAML ones share store;
+// SQLite opens the database at sqlitePath, keeping comments and attachments
+// in store.
+func openStorage(driver config.Driver, store *yamlstore.Store, sqlitePath string) (*storage, error) {
+	if driver == config.DriverSQLite {
+		db, err := sqlite.Open(sqlitePath, store)
+		if err != nil {
+			return nil, err
+		}
+		return &storage{
+			boards:    db,
+			cards:     sqlite.NewCardRepositoryAdapter(db),
+			trash:     sqlite.NewTrashRepositoryAdapter(db),
+			trashCopy: db,
+			counters:  db,
+			close:     db.Close,
+		}, nil
+	}
+	return &storage{
+		boards:    store,
+		cards:     yamlstore.NewCardRepositoryAdapter(store),
+		trash:     yamlstore.NewTrashRepositoryAdapter(store),
+		trashCopy: store,
+		counters:  store,
+		watched:   true,
+		close:     func() error { return nil },
+	}, nil
+}
+
+// notifyWrites makes the repositories report their writes to n, unless the
+// file watcher already sees them.
+func (s *storage) notifyWrites(n usecase.ChangeNotifier) {
+	if s.watched {
+		return
+	}
+	s.boards = usecase.NotifyingBoardRepository(s.boards, n)
+	s.cards = usecase.NotifyingCardRepository(s.cards, n)
+	s.trash = usecase.NotifyingTrashRepository(s.trash, n)
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/config"
+	yamlstore "github.com/hiroto-aibara/secretary-ai/internal/infra/yaml"
+)
+
+// runUpgrade brings the board and card files up to the schema version of
+// this build and records it in config.yaml. With --dry-run it only prints
+// the files each step would change. It returns the exit code.
+func runUpgrade(basePath string, cfg *config.Config, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("upgrade", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	dryRun := fs.Bool("dry-run", false, "report what would change without writing anything")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	store := yamlstore.NewStore(basePath)
+	steps, err := store.Migrate(context.Background(), cfg.SchemaVersion, *dryRun)
+	if err != nil {
+		fmt.Fprintln(stderr, "upgrade:", err)
+		return 1
+	}
+	if len(steps) == 0 {
+		fmt.Fprintf(stdout, "schema version %d is up to date\n", cfg.SchemaVersion)
+		return 0
+	}
+	for _, step := range steps {
+		fmt.Fprintf(stdout, "version %d: %s (%d files)\n", step.Version, step.Description, len(step.Files))
+		for _, f := range step.Files {
+			fmt.Fprintf(stdout, "  %s\n", f)
+		}
+	}
+	if *dryRun {
+		fmt.Fprintf(stdout, "dry run: schema version %d would be upgraded to %d\n", cfg.SchemaVersion, yamlstore.SchemaVersion)
+		return 0
+	}
+	if err := config.SetSchemaVersion(basePath, yamlstore.SchemaVersion); err != nil {
+		fmt.Fprintln(stderr, "upgrade:", err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "upgraded schema version %d to %d\n", cfg.SchemaVersion, yamlstore.SchemaVersion)
+	return 0
+}
+
+// upgradeSchema runs the pending migrations before the server starts, so
+// that it only ever sees files at the current schema version.
+func upgradeSchema(ctx context.Context, basePath string, cfg *config.Config, store *yamlstore.Store) error {
+	if cfg.SchemaVersion == yamlstore.SchemaVersion {
+		return nil
+	}
+	steps, err := store.Migrate(ctx, cfg.SchemaVersion, false)
+	if err != nil {
+		return err
+	}
+	for _, step := range steps {
+		slog.Info("schema migrated", "version", step.Version, "description", step.Description, "files", len(step.Files))
+	}
+	if err := config.SetSchemaVersion(basePath, yamlstore.SchemaVersion); err != nil {
+		return err
+	}
+	cfg.SchemaVersion = yamlstore.SchemaVersion
+	return nil
+}
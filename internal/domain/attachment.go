@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// Attachment is the metadata of a file attached to a card.
+// The file content itself is kept by an AttachmentRepository.
+type Attachment struct {
+	ID          string    `json:"id" yaml:"id"`
+	Filename    string    `json:"filename" yaml:"filename"`
+	Size        int64     `json:"size" yaml:"size"`
+	ContentType string    `json:"content_type" yaml:"content_type"`
+	SHA256      string    `json:"sha256" yaml:"sha256"`
+	CreatedAt   time.Time `json:"created_at" yaml:"created_at"`
+}
+
+// FindAttachment returns the index of the attachment with the given ID, or -1.
+func (c *Card) FindAttachment(id string) int {
+	for i := range c.Attachments {
+		if c.Attachments[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -3,12 +3,31 @@ package domain
 type List struct {
 	ID   string `json:"id" yaml:"id"`
 	Name string `json:"name" yaml:"name"`
+	// Done marks a list whose cards are considered finished.
+	Done bool `json:"done,omitempty" yaml:"done,omitempty"`
 }
 
+// CardFormat is the file format a board's new cards are written in. Cards
+// already on disk keep the format they have.
+type CardFormat string
+
+const (
+	CardFormatYAML     CardFormat = "yaml"
+	CardFormatMarkdown CardFormat = "markdown"
+)
+
 type Board struct {
-	ID    string `json:"id" yaml:"id"`
-	Name  string `json:"name" yaml:"name"`
-	Lists []List `json:"lists" yaml:"lists"`
+	ID     string     `json:"id" yaml:"id"`
+	Name   string     `json:"name" yaml:"name"`
+	Lists  []List     `json:"lists" yaml:"lists"`
+	Fields []FieldDef `json:"fields,omitempty" yaml:"fields,omitempty"`
+	// Archived hides the board from the board list without deleting anything.
+	Archived bool `json:"archived,omitempty" yaml:"archived,omitempty"`
+	// CardFormat defaults to CardFormatYAML.
+	CardFormat CardFormat `json:"card_format,omitempty" yaml:"card_format,omitempty"`
+	// IDFormat defaults to CardIDDate. IDPrefix is used by CardIDPrefix.
+	IDFormat CardIDFormat `json:"id_format,omitempty" yaml:"id_format,omitempty"`
+	IDPrefix string       `json:"id_prefix,omitempty" yaml:"id_prefix,omitempty"`
 }
 
 func (b *Board) Validate() error {
@@ -29,6 +48,24 @@ func (b *Board) Validate() error {
 			return &ErrValidation{Field: "lists.name", Message: "is required"}
 		}
 	}
+	switch b.CardFormat {
+	case "", CardFormatYAML, CardFormatMarkdown:
+	default:
+		return &ErrValidation{Field: "card_format", Message: "must be 'yaml' or 'markdown'"}
+	}
+	if err := b.validateCardIDs(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(b.Fields))
+	for i := range b.Fields {
+		if err := b.Fields[i].Validate(); err != nil {
+			return err
+		}
+		if seen[b.Fields[i].ID] {
+			return &ErrValidation{Field: "fields.id", Message: "duplicate id '" + b.Fields[i].ID + "'"}
+		}
+		seen[b.Fields[i].ID] = true
+	}
 	return nil
 }
 
@@ -40,3 +77,13 @@ func (b *Board) HasList(listID string) bool {
 	}
 	return false
 }
+
+// IsDoneList reports whether listID refers to a list marked as done.
+func (b *Board) IsDoneList(listID string) bool {
+	for _, l := range b.Lists {
+		if l.ID == listID {
+			return l.Done
+		}
+	}
+	return false
+}
@@ -49,6 +49,43 @@ func TestBoard_Validate(t *testing.T) {
 			wantErr: true,
 			field:   "lists.name",
 		},
+		{
+			name: "duplicate field id",
+			board: domain.Board{ID: "test", Name: "Test", Lists: []domain.List{{ID: "todo", Name: "Todo"}}, Fields: []domain.FieldDef{
+				{ID: "version", Name: "Version", Type: domain.FieldText},
+				{ID: "version", Name: "Version 2", Type: domain.FieldText},
+			}},
+			wantErr: true,
+			field:   "fields.id",
+		},
+		{
+			name:    "markdown card format",
+			board:   domain.Board{ID: "test", Name: "Test", Lists: []domain.List{{ID: "todo", Name: "Todo"}}, CardFormat: domain.CardFormatMarkdown},
+			wantErr: false,
+		},
+		{
+			name:    "unknown card format",
+			board:   domain.Board{ID: "test", Name: "Test", Lists: []domain.List{{ID: "todo", Name: "Todo"}}, CardFormat: "json"},
+			wantErr: true,
+			field:   "card_format",
+		},
+		{
+			name:    "prefix ids",
+			board:   domain.Board{ID: "test", Name: "Test", Lists: []domain.List{{ID: "todo", Name: "Todo"}}, IDFormat: domain.CardIDPrefix, IDPrefix: "PROJ"},
+			wantErr: false,
+		},
+		{
+			name:    "prefix ids without prefix",
+			board:   domain.Board{ID: "test", Name: "Test", Lists: []domain.List{{ID: "todo", Name: "Todo"}}, IDFormat: domain.CardIDPrefix},
+			wantErr: true,
+			field:   "id_prefix",
+		},
+		{
+			name:    "unknown id format",
+			board:   domain.Board{ID: "test", Name: "Test", Lists: []domain.List{{ID: "todo", Name: "Todo"}}, IDFormat: "uuid"},
+			wantErr: true,
+			field:   "id_format",
+		},
 	}
 
 	for _, tt := range tests {
@@ -98,3 +135,29 @@ func TestBoard_HasList(t *testing.T) {
 		})
 	}
 }
+
+func TestBoard_IsDoneList(t *testing.T) {
+	board := domain.Board{
+		Lists: []domain.List{
+			{ID: "todo", Name: "Todo"},
+			{ID: "done", Name: "Done", Done: true},
+		},
+	}
+
+	tests := []struct {
+		listID string
+		want   bool
+	}{
+		{"todo", false},
+		{"done", true},
+		{"missing", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.listID, func(t *testing.T) {
+			if got := board.IsDoneList(tt.listID); got != tt.want {
+				t.Errorf("IsDoneList(%s) = %v, want %v", tt.listID, got, tt.want)
+			}
+		})
+	}
+}
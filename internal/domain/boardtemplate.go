@@ -0,0 +1,75 @@
+package domain
+
+import "slices"
+
+// BoardTemplate is a starting point for new boards. Its ID is the template's
+// file name, or a fixed name for the built-in templates.
+type BoardTemplate struct {
+	ID          string     `json:"id" yaml:"-"`
+	Name        string     `json:"name" yaml:"name"`
+	Description string     `json:"description,omitempty" yaml:"description,omitempty"`
+	Lists       []List     `json:"lists" yaml:"lists"`
+	Fields      []FieldDef `json:"fields,omitempty" yaml:"fields,omitempty"`
+	Builtin     bool       `json:"builtin" yaml:"-"`
+}
+
+// ApplyTo fills the lists and fields of board that are still empty.
+func (t *BoardTemplate) ApplyTo(board *Board) {
+	if len(board.Lists) == 0 {
+		board.Lists = slices.Clone(t.Lists)
+	}
+	if board.Fields == nil && len(t.Fields) > 0 {
+		board.Fields = slices.Clone(t.Fields)
+	}
+}
+
+// BuiltinBoardTemplates returns the board templates available without any
+// configuration.
+func BuiltinBoardTemplates() []BoardTemplate {
+	return []BoardTemplate{
+		{
+			ID:          "bug-triage",
+			Name:        "Bug triage",
+			Description: "Incoming bugs sorted by severity until they are fixed or rejected.",
+			Lists: []List{
+				{ID: "new", Name: "New"},
+				{ID: "triaged", Name: "Triaged"},
+				{ID: "fixing", Name: "Fixing"},
+				{ID: "fixed", Name: "Fixed", Done: true},
+				{ID: "wont-fix", Name: "Won't fix", Done: true},
+			},
+			Fields: []FieldDef{
+				{ID: "severity", Name: "Severity", Type: FieldEnum, Options: []string{"low", "medium", "high", "critical"}},
+				{ID: "version", Name: "Version", Type: FieldText},
+			},
+			Builtin: true,
+		},
+		{
+			ID:          "kanban",
+			Name:        "Basic kanban",
+			Description: "Todo, in progress and done.",
+			Lists: []List{
+				{ID: "todo", Name: "Todo"},
+				{ID: "in-progress", Name: "In Progress"},
+				{ID: "done", Name: "Done", Done: true},
+			},
+			Builtin: true,
+		},
+		{
+			ID:          "scrum",
+			Name:        "Scrum",
+			Description: "Product backlog through review, with story points.",
+			Lists: []List{
+				{ID: "backlog", Name: "Backlog"},
+				{ID: "sprint", Name: "Sprint Backlog"},
+				{ID: "in-progress", Name: "In Progress"},
+				{ID: "review", Name: "Review"},
+				{ID: "done", Name: "Done", Done: true},
+			},
+			Fields: []FieldDef{
+				{ID: "points", Name: "Story points", Type: FieldNumber},
+			},
+			Builtin: true,
+		},
+	}
+}
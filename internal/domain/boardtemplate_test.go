@@ -0,0 +1,17 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func TestBuiltinBoardTemplates_Valid(t *testing.T) {
+	for _, tmpl := range domain.BuiltinBoardTemplates() {
+		board := domain.Board{ID: "b", Name: "B"}
+		tmpl.ApplyTo(&board)
+		if err := board.Validate(); err != nil {
+			t.Errorf("template %s: %v", tmpl.ID, err)
+		}
+	}
+}
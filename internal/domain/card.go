@@ -1,6 +1,15 @@
 package domain
 
-import "time"
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxTodoTextLength is the maximum number of characters in a todo item.
+const MaxTodoTextLength = 500
 
 // TodoItem represents a single todo item within a card
 type TodoItem struct {
@@ -9,17 +18,47 @@ type TodoItem struct {
 	Completed bool   `json:"completed" yaml:"completed"`
 }
 
+// CardRef identifies a card, possibly on another board.
+type CardRef struct {
+	Board string `json:"board" yaml:"board"`
+	ID    string `json:"id" yaml:"id"`
+}
+
+func (t *TodoItem) Validate() error {
+	text := strings.TrimSpace(t.Text)
+	if text == "" {
+		return &ErrValidation{Field: "todos.text", Message: "is required"}
+	}
+	if utf8.RuneCountInString(text) > MaxTodoTextLength {
+		return &ErrValidation{Field: "todos.text", Message: "must be at most 500 characters"}
+	}
+	return nil
+}
+
 type Card struct {
-	ID          string     `json:"id" yaml:"id"`
-	Title       string     `json:"title" yaml:"title"`
-	List        string     `json:"list" yaml:"list"`
-	Order       int        `json:"order" yaml:"order"`
-	Description string     `json:"description" yaml:"description"`
-	Labels      []string   `json:"labels" yaml:"labels"`
-	Todos       []TodoItem `json:"todos" yaml:"todos"`
-	Archived    bool       `json:"archived" yaml:"archived"`
-	CreatedAt   time.Time  `json:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at" yaml:"updated_at"`
+	ID    string `json:"id" yaml:"id"`
+	Title string `json:"title" yaml:"title"`
+	List  string `json:"list" yaml:"list"`
+	// Rank orders the card within its list; see RankBetween.
+	Rank string `json:"rank" yaml:"rank"`
+	// Order is the card's position within its list, filled in by SortByRank.
+	// It is only stored by earlier versions, which used it instead of Rank.
+	Order       int            `json:"order" yaml:"order,omitempty"`
+	Description string         `json:"description" yaml:"description"`
+	Labels      []string       `json:"labels" yaml:"labels"`
+	Todos       []TodoItem     `json:"todos" yaml:"todos"`
+	BlockedBy   []CardRef      `json:"blocked_by,omitempty" yaml:"blocked_by,omitempty"`
+	Parent      string         `json:"parent,omitempty" yaml:"parent,omitempty"`
+	Attachments []Attachment   `json:"attachments,omitempty" yaml:"attachments,omitempty"`
+	Fields      map[string]any `json:"fields,omitempty" yaml:"fields,omitempty"`
+	// EstimateMinutes is the planned effort; 0 means no estimate.
+	EstimateMinutes int         `json:"estimate_minutes,omitempty" yaml:"estimate_minutes,omitempty"`
+	TimeEntries     []TimeEntry `json:"time_entries,omitempty" yaml:"time_entries,omitempty"`
+	// Recurrence identifies the recurring template occurrence that created the card.
+	Recurrence string    `json:"recurrence,omitempty" yaml:"recurrence,omitempty"`
+	Archived   bool      `json:"archived" yaml:"archived"`
+	CreatedAt  time.Time `json:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" yaml:"updated_at"`
 }
 
 func (c *Card) Validate() error {
@@ -29,5 +68,59 @@ func (c *Card) Validate() error {
 	if c.List == "" {
 		return &ErrValidation{Field: "list", Message: "is required"}
 	}
+	if c.EstimateMinutes < 0 {
+		return &ErrValidation{Field: "estimate_minutes", Message: "must not be negative"}
+	}
 	return nil
 }
+
+// Clone returns a copy of c that shares no slices or maps with it.
+func (c *Card) Clone() *Card {
+	cp := *c
+	cp.Labels = slices.Clone(c.Labels)
+	cp.Todos = slices.Clone(c.Todos)
+	cp.BlockedBy = slices.Clone(c.BlockedBy)
+	cp.Attachments = slices.Clone(c.Attachments)
+	cp.Fields = maps.Clone(c.Fields)
+	cp.TimeEntries = slices.Clone(c.TimeEntries)
+	return &cp
+}
+
+// CardProgress is the rolled-up completion of a parent card and its subtasks.
+type CardProgress struct {
+	ChildrenDone  int `json:"children_done"`
+	ChildrenTotal int `json:"children_total"`
+	TodosDone     int `json:"todos_done"`
+	TodosTotal    int `json:"todos_total"`
+}
+
+// IsBlockedBy reports whether the card has a blocked-by link to ref.
+func (c *Card) IsBlockedBy(ref CardRef) bool {
+	for _, r := range c.BlockedBy {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// CompletedTodos returns the number of completed todo items.
+func (c *Card) CompletedTodos() int {
+	n := 0
+	for _, t := range c.Todos {
+		if t.Completed {
+			n++
+		}
+	}
+	return n
+}
+
+// FindTodo returns the index of the todo item with the given ID, or -1.
+func (c *Card) FindTodo(id string) int {
+	for i := range c.Todos {
+		if c.Todos[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
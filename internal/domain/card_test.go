@@ -2,6 +2,7 @@ package domain_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/hiroto-aibara/secretary-ai/internal/domain"
@@ -53,3 +54,67 @@ func TestCard_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestCard_IsBlockedBy(t *testing.T) {
+	card := domain.Card{BlockedBy: []domain.CardRef{{Board: "board-1", ID: "001"}}}
+
+	if !card.IsBlockedBy(domain.CardRef{Board: "board-1", ID: "001"}) {
+		t.Error("expected card to be blocked by board-1/001")
+	}
+	if card.IsBlockedBy(domain.CardRef{Board: "board-2", ID: "001"}) {
+		t.Error("card should not be blocked by board-2/001")
+	}
+}
+
+func TestCard_CompletedTodos(t *testing.T) {
+	card := domain.Card{Todos: []domain.TodoItem{
+		{ID: "1", Text: "a", Completed: true},
+		{ID: "2", Text: "b"},
+		{ID: "3", Text: "c", Completed: true},
+	}}
+	if got := card.CompletedTodos(); got != 2 {
+		t.Errorf("CompletedTodos() = %d, want 2", got)
+	}
+}
+
+func TestTodoItem_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr bool
+	}{
+		{"valid", "write docs", false},
+		{"empty", "", true},
+		{"whitespace only", "  \t", true},
+		{"max length", strings.Repeat("あ", domain.MaxTodoTextLength), false},
+		{"too long", strings.Repeat("a", domain.MaxTodoTextLength+1), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todo := domain.TodoItem{ID: "1", Text: tt.text}
+			if err := todo.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCard_Clone(t *testing.T) {
+	card := &domain.Card{
+		ID:        "card-1",
+		Labels:    []string{"bug"},
+		Todos:     []domain.TodoItem{{ID: "1", Text: "a"}},
+		BlockedBy: []domain.CardRef{{Board: "b", ID: "card-2"}},
+		Fields:    map[string]any{"points": 3},
+	}
+	cp := card.Clone()
+	cp.Labels[0] = "x"
+	cp.Todos[0].Text = "x"
+	cp.BlockedBy[0].ID = "x"
+	cp.Fields["points"] = 5
+
+	if card.Labels[0] != "bug" || card.Todos[0].Text != "a" || card.BlockedBy[0].ID != "card-2" || card.Fields["points"] != 3 {
+		t.Errorf("original changed through clone: %+v", card)
+	}
+}
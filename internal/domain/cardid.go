@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CardIDFormat is how a board names its new cards. Changing it leaves the
+// IDs of existing cards as they are.
+type CardIDFormat string
+
+const (
+	// CardIDDate numbers cards within each day: 20260124-001. The default.
+	CardIDDate CardIDFormat = "date"
+	// CardIDPrefix numbers cards with the board's prefix and a counter that
+	// never goes back, even when cards are deleted: PROJ-123.
+	CardIDPrefix CardIDFormat = "prefix"
+	// CardIDULID names cards with a ULID, which does not collide between
+	// branches that create cards independently.
+	CardIDULID CardIDFormat = "ulid"
+)
+
+var idPrefixPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
+func (b *Board) validateCardIDs() error {
+	switch b.IDFormat {
+	case "", CardIDDate, CardIDULID:
+	case CardIDPrefix:
+		if !idPrefixPattern.MatchString(b.IDPrefix) {
+			return &ErrValidation{Field: "id_prefix", Message: "must be letters and digits, starting with a letter"}
+		}
+	default:
+		return &ErrValidation{Field: "id_format", Message: "must be 'date', 'prefix' or 'ulid'"}
+	}
+	return nil
+}
+
+// NextCardID returns a new ID for a card of board, which is nil when the
+// board has not been saved. taken are the IDs of the board's cards. counter
+// is the last number issued on a prefix board, which the store keeps so that
+// numbers of deleted cards are not issued again; the returned counter
+// replaces it. Numbers already taken count too, so a stale counter, such as
+// one from another git branch, does not lead to a collision.
+func NextCardID(board *Board, taken []string, counter int, now time.Time) (string, int) {
+	format := CardIDDate
+	if board != nil && board.IDFormat != "" {
+		format = board.IDFormat
+	}
+
+	switch format {
+	case CardIDPrefix:
+		n := max(counter, maxIDNumber(taken, board.IDPrefix+"-")) + 1
+		return board.IDPrefix + "-" + strconv.Itoa(n), n
+	case CardIDULID:
+		for {
+			if id := NewULID(now); !slices.Contains(taken, id) {
+				return id, counter
+			}
+		}
+	default:
+		day := now.Format("20060102")
+		return fmt.Sprintf("%s-%03d", day, maxIDNumber(taken, day+"-")+1), counter
+	}
+}
+
+// maxIDNumber returns the largest number that follows prefix in ids, or 0.
+func maxIDNumber(ids []string, prefix string) int {
+	maxN := 0
+	for _, id := range ids {
+		rest, ok := strings.CutPrefix(id, prefix)
+		if !ok {
+			continue
+		}
+		if n, err := strconv.Atoi(rest); err == nil {
+			maxN = max(maxN, n)
+		}
+	}
+	return maxN
+}
+
+// crockford is the alphabet of ULIDs.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// NewULID returns a ULID for t: 26 characters that sort by time, made of a
+// 48-bit millisecond timestamp and 80 random bits.
+func NewULID(t time.Time) string {
+	var b [16]byte
+	binary.BigEndian.PutUint64(b[:8], uint64(t.UnixMilli())<<16)
+	_, _ = rand.Read(b[6:])
+
+	hi := binary.BigEndian.Uint64(b[:8])
+	lo := binary.BigEndian.Uint64(b[8:])
+	var out [26]byte
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = crockford[lo&31]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(out[:])
+}
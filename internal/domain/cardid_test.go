@@ -0,0 +1,62 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func TestNextCardID(t *testing.T) {
+	now := time.Date(2026, 1, 24, 10, 0, 0, 0, time.UTC)
+	prefix := &domain.Board{IDFormat: domain.CardIDPrefix, IDPrefix: "PROJ"}
+
+	tests := []struct {
+		name        string
+		board       *domain.Board
+		taken       []string
+		counter     int
+		want        string
+		wantCounter int
+	}{
+		{name: "no board", board: nil, want: "20260124-001"},
+		{name: "date", board: &domain.Board{}, taken: []string{"20260124-001", "20260124-007", "20260123-042", "PROJ-9"}, want: "20260124-008"},
+		{name: "date past 999", board: &domain.Board{IDFormat: domain.CardIDDate}, taken: []string{"20260124-999"}, want: "20260124-1000"},
+		{name: "prefix from counter", board: prefix, taken: []string{"PROJ-3"}, counter: 12, want: "PROJ-13", wantCounter: 13},
+		{name: "prefix past stale counter", board: prefix, taken: []string{"PROJ-3", "PROJ-20", "20260124-050"}, counter: 12, want: "PROJ-21", wantCounter: 21},
+		{name: "prefix first", board: prefix, want: "PROJ-1", wantCounter: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, counter := domain.NextCardID(tt.board, tt.taken, tt.counter, now)
+			if got != tt.want || counter != tt.wantCounter {
+				t.Errorf("NextCardID = %q, %d; want %q, %d", got, counter, tt.want, tt.wantCounter)
+			}
+		})
+	}
+}
+
+func TestNewULID(t *testing.T) {
+	earlier := domain.NewULID(time.Date(2026, 1, 24, 10, 0, 0, 0, time.UTC))
+	later := domain.NewULID(time.Date(2026, 1, 24, 10, 0, 0, int(time.Millisecond), time.UTC))
+	if len(earlier) != 26 || strings.Trim(earlier, "0123456789ABCDEFGHJKMNPQRSTVWXYZ") != "" {
+		t.Errorf("ULID %q is not 26 Crockford base32 characters", earlier)
+	}
+	if earlier >= later {
+		t.Errorf("ULIDs do not sort by time: %q >= %q", earlier, later)
+	}
+	if again := domain.NewULID(time.Date(2026, 1, 24, 10, 0, 0, 0, time.UTC)); again == earlier {
+		t.Errorf("two ULIDs for the same time are equal: %q", again)
+	}
+	// 2026-01-24T10:00:00Z is 1769248800000 ms.
+	if want := "01KFQQ4F80"; earlier[:10] != want {
+		t.Errorf("timestamp part = %q, want %q", earlier[:10], want)
+	}
+
+	id, _ := domain.NextCardID(&domain.Board{IDFormat: domain.CardIDULID}, nil, 0, time.Now())
+	if len(id) != 26 {
+		t.Errorf("ulid board ID = %q", id)
+	}
+}
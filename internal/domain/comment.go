@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Comment is a discussion entry attached to a card.
+type Comment struct {
+	ID        string    `json:"id" yaml:"id"`
+	Author    string    `json:"author" yaml:"author"`
+	Body      string    `json:"body" yaml:"body"`
+	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" yaml:"updated_at"`
+}
+
+func (c *Comment) Validate() error {
+	if c.Author == "" {
+		return &ErrValidation{Field: "author", Message: "is required"}
+	}
+	if c.Body == "" {
+		return &ErrValidation{Field: "body", Message: "is required"}
+	}
+	return nil
+}
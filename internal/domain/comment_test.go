@@ -0,0 +1,54 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func TestComment_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment domain.Comment
+		wantErr bool
+		field   string
+	}{
+		{
+			name:    "valid comment",
+			comment: domain.Comment{Author: "alice", Body: "LGTM"},
+		},
+		{
+			name:    "missing author",
+			comment: domain.Comment{Body: "LGTM"},
+			wantErr: true,
+			field:   "author",
+		},
+		{
+			name:    "missing body",
+			comment: domain.Comment{Author: "alice"},
+			wantErr: true,
+			field:   "body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.comment.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var ve *domain.ErrValidation
+				if !errors.As(err, &ve) {
+					t.Errorf("expected ErrValidation, got %T", err)
+					return
+				}
+				if ve.Field != tt.field {
+					t.Errorf("field = %s, want %s", ve.Field, tt.field)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package domain
+
+// DependencyNode is a card that appears in a board's dependency graph.
+// Cards from other boards are included when they are linked to a card on the board.
+type DependencyNode struct {
+	CardRef
+	Title    string `json:"title"`
+	List     string `json:"list"`
+	Resolved bool   `json:"resolved"`
+}
+
+// DependencyEdge points from a blocking card to the card it blocks.
+type DependencyEdge struct {
+	From CardRef `json:"from"`
+	To   CardRef `json:"to"`
+}
+
+type DependencyGraph struct {
+	BoardID string           `json:"board_id"`
+	Nodes   []DependencyNode `json:"nodes"`
+	Edges   []DependencyEdge `json:"edges"`
+}
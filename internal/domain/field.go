@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"net/url"
+	"slices"
+	"time"
+)
+
+// FieldType is the value type of a custom field.
+type FieldType string
+
+const (
+	FieldText   FieldType = "text"
+	FieldNumber FieldType = "number"
+	FieldEnum   FieldType = "enum"
+	FieldDate   FieldType = "date"
+	FieldBool   FieldType = "bool"
+	FieldURL    FieldType = "url"
+)
+
+// FieldDateLayout is the format of date field values.
+const FieldDateLayout = "2006-01-02"
+
+// FieldDef declares a custom field that cards on a board may carry.
+type FieldDef struct {
+	ID       string    `json:"id" yaml:"id"`
+	Name     string    `json:"name" yaml:"name"`
+	Type     FieldType `json:"type" yaml:"type"`
+	Options  []string  `json:"options,omitempty" yaml:"options,omitempty"`
+	Required bool      `json:"required,omitempty" yaml:"required,omitempty"`
+}
+
+func (f *FieldDef) Validate() error {
+	if f.ID == "" {
+		return &ErrValidation{Field: "fields.id", Message: "is required"}
+	}
+	if f.Name == "" {
+		return &ErrValidation{Field: "fields.name", Message: "is required"}
+	}
+	switch f.Type {
+	case FieldText, FieldNumber, FieldDate, FieldBool, FieldURL:
+	case FieldEnum:
+		if len(f.Options) == 0 {
+			return &ErrValidation{Field: "fields.options", Message: "must have at least one option for enum field '" + f.ID + "'"}
+		}
+	default:
+		return &ErrValidation{Field: "fields.type", Message: "unknown type '" + string(f.Type) + "'"}
+	}
+	return nil
+}
+
+// ValidateValue checks that v is a valid value for the field.
+func (f *FieldDef) ValidateValue(v any) error {
+	invalid := &ErrValidation{Field: "fields." + f.ID, Message: "must be a valid " + string(f.Type)}
+	switch f.Type {
+	case FieldText:
+		if _, ok := v.(string); !ok {
+			return invalid
+		}
+	case FieldNumber:
+		switch v.(type) {
+		case int, int64, uint64, float64:
+		default:
+			return invalid
+		}
+	case FieldEnum:
+		s, ok := v.(string)
+		if !ok || !slices.Contains(f.Options, s) {
+			return &ErrValidation{Field: "fields." + f.ID, Message: "must be one of the field options"}
+		}
+	case FieldDate:
+		switch d := v.(type) {
+		case time.Time:
+		case string:
+			if _, err := time.Parse(FieldDateLayout, d); err != nil {
+				return invalid
+			}
+		default:
+			return invalid
+		}
+	case FieldBool:
+		if _, ok := v.(bool); !ok {
+			return invalid
+		}
+	case FieldURL:
+		s, ok := v.(string)
+		if !ok {
+			return invalid
+		}
+		u, err := url.Parse(s)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return invalid
+		}
+	}
+	return nil
+}
+
+// Field returns the custom field definition with the given ID.
+func (b *Board) Field(id string) (*FieldDef, bool) {
+	for i := range b.Fields {
+		if b.Fields[i].ID == id {
+			return &b.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// ValidateFieldValues checks card field values against the board's schema.
+func (b *Board) ValidateFieldValues(values map[string]any) error {
+	for id, v := range values {
+		def, ok := b.Field(id)
+		if !ok {
+			return &ErrValidation{Field: "fields." + id, Message: "is not defined on this board"}
+		}
+		if err := def.ValidateValue(v); err != nil {
+			return err
+		}
+	}
+	for _, def := range b.Fields {
+		if _, ok := values[def.ID]; def.Required && !ok {
+			return &ErrValidation{Field: "fields." + def.ID, Message: "is required"}
+		}
+	}
+	return nil
+}
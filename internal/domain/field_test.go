@@ -0,0 +1,105 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func TestFieldDef_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		def     domain.FieldDef
+		wantErr bool
+		field   string
+	}{
+		{name: "valid text", def: domain.FieldDef{ID: "version", Name: "Version", Type: domain.FieldText}},
+		{name: "valid enum", def: domain.FieldDef{ID: "severity", Name: "Severity", Type: domain.FieldEnum, Options: []string{"low", "high"}}},
+		{name: "missing id", def: domain.FieldDef{Name: "Version", Type: domain.FieldText}, wantErr: true, field: "fields.id"},
+		{name: "missing name", def: domain.FieldDef{ID: "version", Type: domain.FieldText}, wantErr: true, field: "fields.name"},
+		{name: "unknown type", def: domain.FieldDef{ID: "x", Name: "X", Type: "color"}, wantErr: true, field: "fields.type"},
+		{name: "enum without options", def: domain.FieldDef{ID: "x", Name: "X", Type: domain.FieldEnum}, wantErr: true, field: "fields.options"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.def.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var ve *domain.ErrValidation
+				if !errors.As(err, &ve) {
+					t.Errorf("expected ErrValidation, got %T", err)
+					return
+				}
+				if ve.Field != tt.field {
+					t.Errorf("field = %s, want %s", ve.Field, tt.field)
+				}
+			}
+		})
+	}
+}
+
+func TestFieldDef_ValidateValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     domain.FieldType
+		value   any
+		wantErr bool
+	}{
+		{"text", domain.FieldText, "1.2.0", false},
+		{"text not string", domain.FieldText, 3, true},
+		{"number float", domain.FieldNumber, 1.5, false},
+		{"number int", domain.FieldNumber, 3, false},
+		{"number string", domain.FieldNumber, "3", true},
+		{"enum option", domain.FieldEnum, "high", false},
+		{"enum unknown", domain.FieldEnum, "critical", true},
+		{"date string", domain.FieldDate, "2026-01-24", false},
+		{"date time", domain.FieldDate, time.Date(2026, 1, 24, 0, 0, 0, 0, time.UTC), false},
+		{"date invalid", domain.FieldDate, "24/01/2026", true},
+		{"bool", domain.FieldBool, true, false},
+		{"bool string", domain.FieldBool, "true", true},
+		{"url", domain.FieldURL, "https://example.com/issue/1", false},
+		{"url relative", domain.FieldURL, "/issue/1", true},
+		{"url scheme", domain.FieldURL, "javascript:alert(1)", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := domain.FieldDef{ID: "f", Name: "F", Type: tt.typ, Options: []string{"low", "high"}}
+			if err := def.ValidateValue(tt.value); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateValue(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBoard_ValidateFieldValues(t *testing.T) {
+	board := domain.Board{Fields: []domain.FieldDef{
+		{ID: "severity", Name: "Severity", Type: domain.FieldEnum, Options: []string{"low", "high"}, Required: true},
+		{ID: "version", Name: "Version", Type: domain.FieldText},
+	}}
+
+	tests := []struct {
+		name    string
+		values  map[string]any
+		wantErr bool
+	}{
+		{"valid", map[string]any{"severity": "low", "version": "1.0"}, false},
+		{"missing required", map[string]any{"version": "1.0"}, true},
+		{"undefined field", map[string]any{"severity": "low", "channel": "blog"}, true},
+		{"invalid value", map[string]any{"severity": "urgent"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := board.ValidateFieldValues(tt.values); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateFieldValues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// A rank is a card's position within its list as a string of base-36 digits;
+// cards sort by comparing ranks as plain strings. A rank can always be found
+// between two others, so moving a card only rewrites that card. Ranks never
+// end in '0', which keeps room below every rank.
+
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// MaxRankLength is the rank length beyond which a list should be rebalanced
+// with RankSequence. Repeated inserts at the same spot grow ranks by about one
+// digit per five moves.
+const MaxRankLength = 24
+
+// RankBetween returns a rank that sorts after a and before b. An empty a means
+// the start of the list and an empty b its end. a must sort before b.
+func RankBetween(a, b string) string {
+	var out []byte
+	bounded := b != ""
+	for i := 0; ; i++ {
+		lo := 0
+		if i < len(a) {
+			lo = strings.IndexByte(rankDigits, a[i])
+		}
+		hi := len(rankDigits)
+		if bounded && i < len(b) {
+			hi = strings.IndexByte(rankDigits, b[i])
+		}
+
+		if hi-lo > 1 {
+			return string(append(out, rankDigits[(lo+hi)/2]))
+		}
+		out = append(out, rankDigits[lo])
+		if hi-lo == 1 {
+			// out already sorts before b, whatever follows.
+			bounded = false
+		}
+	}
+}
+
+// RankSequence returns n ascending ranks spread evenly, leaving room to insert
+// between any two of them.
+func RankSequence(n int) []string {
+	width, space := 1, len(rankDigits)
+	for space < (n+1)*len(rankDigits) {
+		width++
+		space *= len(rankDigits)
+	}
+
+	step := space / (n + 1)
+	ranks := make([]string, n)
+	for i := range ranks {
+		// Trailing zeros do not change where a rank sorts.
+		ranks[i] = strings.TrimRight(padRank(strconv.FormatInt(int64((i+1)*step), len(rankDigits)), width), "0")
+	}
+	return ranks
+}
+
+// RankFromOrder maps the integer order stored by earlier versions to a rank
+// that keeps the same relative position, so existing cards need no rewrite.
+func RankFromOrder(order int) string {
+	return padRank(strconv.FormatInt(int64(max(order, 0)), len(rankDigits)), 6) + "i"
+}
+
+func padRank(s string, width int) string {
+	return strings.Repeat("0", max(width-len(s), 0)) + s
+}
+
+// SortByRank sorts cards by list and rank and sets each card's Order to its
+// position within its list.
+func SortByRank(cards []Card) {
+	slices.SortStableFunc(cards, func(a, b Card) int {
+		return cmp.Or(cmp.Compare(a.List, b.List), cmp.Compare(a.Rank, b.Rank), cmp.Compare(a.ID, b.ID))
+	})
+	pos := 0
+	for i := range cards {
+		if i > 0 && cards[i].List != cards[i-1].List {
+			pos = 0
+		}
+		cards[i].Order = pos
+		pos++
+	}
+}
@@ -0,0 +1,107 @@
+package domain_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{name: "empty list", a: "", b: ""},
+		{name: "before first", a: "", b: "i"},
+		{name: "after last", a: "i", b: ""},
+		{name: "adjacent digits", a: "a", b: "b"},
+		{name: "prefix", a: "a", b: "a1"},
+		{name: "below smallest digit", a: "", b: "01"},
+		{name: "after max digits", a: "zz", b: ""},
+		{name: "legacy orders", a: domain.RankFromOrder(35), b: domain.RankFromOrder(36)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := domain.RankBetween(tt.a, tt.b)
+			if got <= tt.a || (tt.b != "" && got >= tt.b) {
+				t.Errorf("RankBetween(%q, %q) = %q, not between", tt.a, tt.b, got)
+			}
+			if strings.HasSuffix(got, "0") {
+				t.Errorf("RankBetween(%q, %q) = %q ends in 0", tt.a, tt.b, got)
+			}
+		})
+	}
+}
+
+func TestRankBetween_RepeatedInserts(t *testing.T) {
+	// Always inserting right after the first card is the worst case for growth.
+	ranks := []string{domain.RankBetween("", "")}
+	for range 100 {
+		next := ""
+		if len(ranks) > 1 {
+			next = ranks[1]
+		}
+		r := domain.RankBetween(ranks[0], next)
+		ranks = slices.Insert(ranks, 1, r)
+	}
+	if !slices.IsSorted(ranks) || len(slices.Compact(slices.Clone(ranks))) != len(ranks) {
+		t.Error("ranks are not strictly ascending")
+	}
+	if l := len(ranks[1]); l > domain.MaxRankLength {
+		t.Errorf("rank length after 100 inserts = %d, want at most %d", l, domain.MaxRankLength)
+	}
+}
+
+func TestRankSequence(t *testing.T) {
+	for _, n := range []int{1, 2, 35, 36, 1000} {
+		ranks := domain.RankSequence(n)
+		if len(ranks) != n {
+			t.Fatalf("n=%d: got %d ranks", n, len(ranks))
+		}
+		if !slices.IsSorted(ranks) {
+			t.Errorf("n=%d: ranks not ascending: %v", n, ranks[:min(n, 5)])
+		}
+		for i := 1; i < n; i++ {
+			if ranks[i-1] == ranks[i] {
+				t.Fatalf("n=%d: duplicate rank %q", n, ranks[i])
+			}
+			if mid := domain.RankBetween(ranks[i-1], ranks[i]); len(mid) > len(ranks[i])+1 {
+				t.Errorf("n=%d: no room between %q and %q", n, ranks[i-1], ranks[i])
+			}
+		}
+	}
+}
+
+func TestRankFromOrder(t *testing.T) {
+	var ranks []string
+	for _, order := range []int{-1, 0, 1, 9, 10, 35, 36, 37, 1295, 1296} {
+		ranks = append(ranks, domain.RankFromOrder(order))
+	}
+	if !slices.IsSorted(ranks) {
+		t.Errorf("ranks do not keep the order: %v", ranks)
+	}
+}
+
+func TestSortByRank(t *testing.T) {
+	cards := []domain.Card{
+		{ID: "c", List: "todo", Rank: "b"},
+		{ID: "a", List: "done", Rank: "i"},
+		{ID: "b", List: "todo", Rank: "a"},
+		{ID: "d", List: "todo", Rank: "b"},
+	}
+	domain.SortByRank(cards)
+
+	var got []string
+	for _, c := range cards {
+		got = append(got, c.ID)
+	}
+	if want := []string{"a", "b", "c", "d"}; !slices.Equal(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+	if cards[0].Order != 0 || cards[1].Order != 0 || cards[3].Order != 2 {
+		t.Errorf("positions = %d %d %d %d", cards[0].Order, cards[1].Order, cards[2].Order, cards[3].Order)
+	}
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence is a parsed subset of an iCalendar RRULE: FREQ (DAILY, WEEKLY,
+// MONTHLY), INTERVAL, BYDAY (weekly only) and BYMONTHDAY (monthly only).
+type Recurrence struct {
+	Freq       string
+	Interval   int
+	ByDay      []time.Weekday
+	ByMonthDay int
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// ParseRecurrence parses a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH".
+// A leading "RRULE:" prefix is accepted.
+func ParseRecurrence(rule string) (*Recurrence, error) {
+	invalid := func(msg string) error {
+		return &ErrValidation{Field: "rule", Message: msg}
+	}
+
+	r := &Recurrence{Interval: 1}
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	if rule == "" {
+		return nil, invalid("is required")
+	}
+
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, invalid("malformed part '" + part + "'")
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Freq = strings.ToUpper(value)
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, invalid("INTERVAL must be a positive integer")
+			}
+			r.Interval = n
+		case "BYDAY":
+			for _, d := range strings.Split(value, ",") {
+				wd, ok := rruleWeekdays[strings.ToUpper(d)]
+				if !ok {
+					return nil, invalid("unknown BYDAY value '" + d + "'")
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		case "BYMONTHDAY":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > 31 {
+				return nil, invalid("BYMONTHDAY must be between 1 and 31")
+			}
+			r.ByMonthDay = n
+		default:
+			return nil, invalid("unsupported part '" + key + "'")
+		}
+	}
+
+	switch r.Freq {
+	case "DAILY":
+		if len(r.ByDay) > 0 || r.ByMonthDay != 0 {
+			return nil, invalid("DAILY does not support BYDAY or BYMONTHDAY")
+		}
+	case "WEEKLY":
+		if r.ByMonthDay != 0 {
+			return nil, invalid("WEEKLY does not support BYMONTHDAY")
+		}
+	case "MONTHLY":
+		if len(r.ByDay) > 0 {
+			return nil, invalid("MONTHLY does not support BYDAY")
+		}
+	case "":
+		return nil, invalid("FREQ is required")
+	default:
+		return nil, invalid("unsupported FREQ '" + r.Freq + "'")
+	}
+	return r, nil
+}
+
+// Next returns the first occurrence strictly after after. Occurrences are
+// anchored at start, which is itself the earliest possible occurrence, and
+// keep its time of day.
+func (r *Recurrence) Next(start, after time.Time) time.Time {
+	if after.Before(start) {
+		after = start.Add(-time.Nanosecond)
+	}
+
+	switch r.Freq {
+	case "DAILY":
+		k := int(after.Sub(start).Hours()/24) / r.Interval
+		for {
+			t := start.AddDate(0, 0, k*r.Interval)
+			if t.After(after) {
+				return t
+			}
+			k++
+		}
+	case "WEEKLY":
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []time.Weekday{start.Weekday()}
+		}
+		offsets := make([]int, 0, len(days))
+		for _, d := range days {
+			offsets = append(offsets, (int(d)+6)%7) // Monday = 0
+		}
+		slices.Sort(offsets)
+
+		weekStart := start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+		k := int(after.Sub(weekStart).Hours()/(24*7)) / r.Interval
+		for {
+			base := weekStart.AddDate(0, 0, 7*k*r.Interval)
+			for _, off := range offsets {
+				t := base.AddDate(0, 0, off)
+				if !t.Before(start) && t.After(after) {
+					return t
+				}
+			}
+			k++
+		}
+	default: // MONTHLY
+		day := r.ByMonthDay
+		if day == 0 {
+			day = start.Day()
+		}
+		months := (after.Year()-start.Year())*12 + int(after.Month()-start.Month())
+		k := max(0, months/r.Interval-1)
+		for {
+			first := time.Date(start.Year(), start.Month()+time.Month(k*r.Interval), 1,
+				start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+			lastDay := first.AddDate(0, 1, -1).Day()
+			t := first.AddDate(0, 0, min(day, lastDay)-1)
+			if !t.Before(start) && t.After(after) {
+				return t
+			}
+			k++
+		}
+	}
+}
+
+// RecurringCard is a template that creates a new card on every occurrence of its rule.
+type RecurringCard struct {
+	ID          string     `json:"id" yaml:"id"`
+	Rule        string     `json:"rule" yaml:"rule"`
+	Start       time.Time  `json:"start" yaml:"start"`
+	Title       string     `json:"title" yaml:"title"`
+	List        string     `json:"list" yaml:"list"`
+	Description string     `json:"description,omitempty" yaml:"description,omitempty"`
+	Labels      []string   `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Todos       []TodoItem `json:"todos,omitempty" yaml:"todos,omitempty"`
+	// LastRun is the most recent occurrence that has been materialized.
+	LastRun time.Time `json:"last_run,omitempty" yaml:"last_run,omitempty"`
+	// NextRun is computed on read and never stored.
+	NextRun time.Time `json:"next_run" yaml:"-"`
+}
+
+func (rc *RecurringCard) Validate() error {
+	if rc.ID == "" {
+		return &ErrValidation{Field: "id", Message: "is required"}
+	}
+	if rc.Title == "" {
+		return &ErrValidation{Field: "title", Message: "is required"}
+	}
+	if rc.List == "" {
+		return &ErrValidation{Field: "list", Message: "is required"}
+	}
+	if rc.Start.IsZero() {
+		return &ErrValidation{Field: "start", Message: "is required"}
+	}
+	_, err := ParseRecurrence(rc.Rule)
+	return err
+}
+
+// Due returns the latest occurrence in (LastRun, now], or false when nothing is due.
+func (rc *RecurringCard) Due(now time.Time) (time.Time, bool) {
+	r, err := ParseRecurrence(rc.Rule)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	occ := r.Next(rc.Start, rc.LastRun)
+	if occ.After(now) {
+		return time.Time{}, false
+	}
+	for {
+		next := r.Next(rc.Start, occ)
+		if next.After(now) {
+			return occ, true
+		}
+		occ = next
+	}
+}
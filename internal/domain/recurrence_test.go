@@ -0,0 +1,103 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr bool
+	}{
+		{name: "daily", rule: "FREQ=DAILY"},
+		{name: "weekly with days", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH"},
+		{name: "monthly with day", rule: "RRULE:FREQ=MONTHLY;BYMONTHDAY=31"},
+		{name: "empty", rule: "", wantErr: true},
+		{name: "missing freq", rule: "INTERVAL=2", wantErr: true},
+		{name: "unsupported freq", rule: "FREQ=YEARLY", wantErr: true},
+		{name: "zero interval", rule: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "unknown day", rule: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "byday on monthly", rule: "FREQ=MONTHLY;BYDAY=MO", wantErr: true},
+		{name: "unsupported part", rule: "FREQ=DAILY;COUNT=3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.ParseRecurrence(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRecurrence() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				var ve *domain.ErrValidation
+				if !errors.As(err, &ve) {
+					t.Errorf("expected ErrValidation, got %T", err)
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrence_Next(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 9, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		after time.Time
+		want  time.Time
+	}{
+		{name: "daily before start", rule: "FREQ=DAILY", start: date(2026, 1, 5), after: time.Time{}, want: date(2026, 1, 5)},
+		{name: "daily", rule: "FREQ=DAILY;INTERVAL=3", start: date(2026, 1, 5), after: date(2026, 1, 6), want: date(2026, 1, 8)},
+		{name: "weekly same weekday", rule: "FREQ=WEEKLY", start: date(2026, 1, 5), after: date(2026, 1, 5), want: date(2026, 1, 12)},
+		{name: "weekly by day", rule: "FREQ=WEEKLY;BYDAY=MO,TH", start: date(2026, 1, 5), after: date(2026, 1, 5), want: date(2026, 1, 8)},
+		{name: "biweekly by day", rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH", start: date(2026, 1, 5), after: date(2026, 1, 8), want: date(2026, 1, 19)},
+		{name: "monthly", rule: "FREQ=MONTHLY", start: date(2026, 1, 15), after: date(2026, 1, 15), want: date(2026, 2, 15)},
+		{name: "monthly clipped to month end", rule: "FREQ=MONTHLY;BYMONTHDAY=31", start: date(2026, 1, 31), after: date(2026, 1, 31), want: date(2026, 2, 28)},
+		{name: "monthly after clipping", rule: "FREQ=MONTHLY;BYMONTHDAY=31", start: date(2026, 1, 31), after: date(2026, 2, 28), want: date(2026, 3, 31)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := domain.ParseRecurrence(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRecurrence: %v", err)
+			}
+			if got := r.Next(tt.start, tt.after); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurringCard_Due(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	rc := domain.RecurringCard{ID: "weekly", Rule: "FREQ=WEEKLY", Start: start, Title: "Report", List: "todo"}
+
+	if _, due := rc.Due(start.Add(-time.Hour)); due {
+		t.Error("expected nothing due before start")
+	}
+
+	// Missed occurrences collapse into the latest one.
+	now := time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)
+	occ, due := rc.Due(now)
+	if !due {
+		t.Fatal("expected an occurrence to be due")
+	}
+	if want := time.Date(2026, 1, 19, 9, 0, 0, 0, time.UTC); !occ.Equal(want) {
+		t.Errorf("Due() = %v, want %v", occ, want)
+	}
+
+	rc.LastRun = occ
+	if _, due := rc.Due(now); due {
+		t.Error("expected nothing due after LastRun is recorded")
+	}
+}
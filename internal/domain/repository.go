@@ -1,19 +1,120 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"io"
+)
 
 type BoardRepository interface {
 	List(ctx context.Context) ([]Board, error)
 	Get(ctx context.Context, id string) (*Board, error)
 	Save(ctx context.Context, board *Board) error
+	// Delete moves the board and everything on it to the trash.
 	Delete(ctx context.Context, id string) error
 }
 
+// CardWrite is one card to save as part of CardRepository.SaveAll.
+type CardWrite struct {
+	BoardID string
+	Card    *Card
+	// Delete moves the card with ID Card.ID to the trash, as
+	// CardRepository.Delete does, instead of saving it.
+	Delete bool
+}
+
 type CardRepository interface {
 	ListByBoard(ctx context.Context, boardID string, includeArchived bool) ([]Card, error)
 	Get(ctx context.Context, boardID, cardID string) (*Card, error)
 	Save(ctx context.Context, boardID string, card *Card) error
+	// SaveAll saves or deletes every card in writes or, if any write fails,
+	// none of them.
+	SaveAll(ctx context.Context, writes []CardWrite) error
+	// Delete moves the card with its comments and attachments to the trash.
 	Delete(ctx context.Context, boardID, cardID string) error
 	NextID(ctx context.Context, boardID string) (string, error)
 	Create(ctx context.Context, boardID string, card *Card) (string, error)
+	// Transfer writes card to toBoardID together with its comments and
+	// attachments and removes it from fromBoardID. The card keeps its ID
+	// unless that ID is taken on the target board; the final ID is returned.
+	Transfer(ctx context.Context, fromBoardID, toBoardID string, card *Card) (string, error)
+}
+
+type CommentRepository interface {
+	ListByCard(ctx context.Context, boardID, cardID string) ([]Comment, error)
+	Get(ctx context.Context, boardID, cardID, commentID string) (*Comment, error)
+	Create(ctx context.Context, boardID, cardID string, comment *Comment) (string, error)
+	Save(ctx context.Context, boardID, cardID string, comment *Comment) error
+	Delete(ctx context.Context, boardID, cardID, commentID string) error
+}
+
+type AttachmentRepository interface {
+	Put(ctx context.Context, boardID, cardID, attachmentID string, r io.Reader) (int64, error)
+	Open(ctx context.Context, boardID, cardID, attachmentID string) (io.ReadCloser, error)
+	Delete(ctx context.Context, boardID, cardID, attachmentID string) error
+}
+
+// CardFileRepository moves the files kept beside cards, their comments and
+// attachments, for a card storage that does not hold them itself. It moves
+// them as the card moves, so that they follow it to another board or ID, to
+// the trash item the card or its board went to, and back. Missing files are
+// not an error.
+type CardFileRepository interface {
+	MoveCardFiles(ctx context.Context, from, to CardRef) error
+	TrashCardFiles(ctx context.Context, card CardRef, itemID string) error
+	RestoreCardFiles(ctx context.Context, itemID string, card CardRef) error
+	// TrashBoardFiles and RestoreBoardFiles move everything kept beside the
+	// cards of a board.
+	TrashBoardFiles(ctx context.Context, boardID, itemID string) error
+	RestoreBoardFiles(ctx context.Context, itemID, boardID string) error
+	// PurgeTrashFiles deletes the files of a trash item for good.
+	PurgeTrashFiles(ctx context.Context, itemID string) error
+}
+
+type RecurringRepository interface {
+	ListByBoard(ctx context.Context, boardID string) ([]RecurringCard, error)
+	Save(ctx context.Context, boardID string, rc *RecurringCard) error
+}
+
+type TemplateRepository interface {
+	ListByBoard(ctx context.Context, boardID string) ([]CardTemplate, error)
+	Get(ctx context.Context, boardID, templateID string) (*CardTemplate, error)
+	Save(ctx context.Context, boardID string, template *CardTemplate) error
+}
+
+// BoardTemplateRepository holds user-defined board templates.
+type BoardTemplateRepository interface {
+	List(ctx context.Context) ([]BoardTemplate, error)
+	Get(ctx context.Context, templateID string) (*BoardTemplate, error)
+}
+
+type ViewRepository interface {
+	ListByBoard(ctx context.Context, boardID string) ([]View, error)
+	Save(ctx context.Context, boardID string, view *View) error
+	Delete(ctx context.Context, boardID, viewID string) error
+}
+
+// TrashRepository holds deleted boards and cards.
+type TrashRepository interface {
+	List(ctx context.Context) ([]TrashItem, error)
+	// Restore puts an item back where it was deleted from and returns it. A
+	// restored card whose ID was reused in the meantime gets a new ID.
+	Restore(ctx context.Context, itemID string) (*TrashItem, error)
+	// Purge deletes an item for good.
+	Purge(ctx context.Context, itemID string) error
+}
+
+// TrashCopier reads and writes a whole trash, keeping item IDs, so that it
+// can be copied to another storage.
+type TrashCopier interface {
+	ExportTrash(ctx context.Context) ([]TrashEntry, error)
+	// ImportTrash adds entries to the trash. An entry whose ID is already
+	// in it is refused.
+	ImportTrash(ctx context.Context, entries []TrashEntry) error
+}
+
+// IDCounterRepository holds the last number issued on each prefix board
+// (see NextCardID). A board without one has counter 0.
+type IDCounterRepository interface {
+	IDCounter(ctx context.Context, boardID string) (int, error)
+	SetIDCounter(ctx context.Context, boardID string, counter int) error
 }
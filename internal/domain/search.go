@@ -0,0 +1,14 @@
+package domain
+
+// SearchHit is a card matching a full-text query.
+type SearchHit struct {
+	BoardID  string   `json:"board_id"`
+	CardID   string   `json:"card_id"`
+	Title    string   `json:"title"`
+	List     string   `json:"list"`
+	Labels   []string `json:"labels"`
+	Archived bool     `json:"archived"`
+	Score    float64  `json:"score"`
+	// Snippet is an excerpt around the first match, or the start of the description.
+	Snippet string `json:"snippet"`
+}
@@ -0,0 +1,75 @@
+package domain
+
+import "regexp"
+
+// templateIDPattern is the form of card and board template IDs, which are
+// file names.
+var templateIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ValidateTemplateID checks that id can be a template's file name, so that one
+// taken from a request cannot name a path outside the templates directory.
+func ValidateTemplateID(id string) error {
+	if !templateIDPattern.MatchString(id) {
+		return &ErrValidation{Field: "template", Message: "is not a template id"}
+	}
+	return nil
+}
+
+// CardTemplate pre-fills a new card. Its ID is the template's file name.
+type CardTemplate struct {
+	ID          string         `json:"id" yaml:"-"`
+	Name        string         `json:"name" yaml:"name"`
+	Title       string         `json:"title,omitempty" yaml:"title,omitempty"`
+	List        string         `json:"list,omitempty" yaml:"list,omitempty"`
+	Description string         `json:"description,omitempty" yaml:"description,omitempty"`
+	Labels      []string       `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Todos       []TodoItem     `json:"todos,omitempty" yaml:"todos,omitempty"`
+	Fields      map[string]any `json:"fields,omitempty" yaml:"fields,omitempty"`
+}
+
+// ApplyTo fills card from the template. Values already set on card win:
+// title, list and description are only filled when empty, labels are merged,
+// template todos come before the card's own, and card field values override
+// the template's.
+func (t *CardTemplate) ApplyTo(card *Card) {
+	if card.Title == "" {
+		card.Title = t.Title
+	}
+	if card.List == "" {
+		card.List = t.List
+	}
+	if card.Description == "" {
+		card.Description = t.Description
+	}
+
+	if len(t.Labels) > 0 {
+		labels := make([]string, 0, len(t.Labels)+len(card.Labels))
+		seen := make(map[string]bool, len(t.Labels)+len(card.Labels))
+		for _, l := range append(append([]string{}, t.Labels...), card.Labels...) {
+			if !seen[l] {
+				seen[l] = true
+				labels = append(labels, l)
+			}
+		}
+		card.Labels = labels
+	}
+
+	if len(t.Todos) > 0 {
+		todos := make([]TodoItem, 0, len(t.Todos)+len(card.Todos))
+		for _, todo := range t.Todos {
+			todos = append(todos, TodoItem{Text: todo.Text})
+		}
+		card.Todos = append(todos, card.Todos...)
+	}
+
+	if len(t.Fields) > 0 {
+		fields := make(map[string]any, len(t.Fields)+len(card.Fields))
+		for k, v := range t.Fields {
+			fields[k] = v
+		}
+		for k, v := range card.Fields {
+			fields[k] = v
+		}
+		card.Fields = fields
+	}
+}
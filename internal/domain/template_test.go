@@ -0,0 +1,46 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func TestCardTemplate_ApplyTo(t *testing.T) {
+	tmpl := domain.CardTemplate{
+		ID:          "bug",
+		Title:       "Bug: ",
+		List:        "todo",
+		Description: "## Steps",
+		Labels:      []string{"bug", "triage"},
+		Todos:       []domain.TodoItem{{ID: "t1", Text: "Reproduce"}},
+		Fields:      map[string]any{"severity": "low", "version": "1.0"},
+	}
+	card := &domain.Card{
+		Title:  "Crash",
+		Labels: []string{"triage", "auth"},
+		Todos:  []domain.TodoItem{{Text: "Write test"}},
+		Fields: map[string]any{"severity": "high"},
+	}
+
+	tmpl.ApplyTo(card)
+
+	if card.Title != "Crash" {
+		t.Errorf("Title = %s, want Crash", card.Title)
+	}
+	if card.List != "todo" || card.Description != "## Steps" {
+		t.Errorf("List/Description not filled: %+v", card)
+	}
+	if want := []string{"bug", "triage", "auth"}; len(card.Labels) != len(want) || card.Labels[0] != want[0] || card.Labels[2] != want[2] {
+		t.Errorf("Labels = %v, want %v", card.Labels, want)
+	}
+	if len(card.Todos) != 2 || card.Todos[0].Text != "Reproduce" || card.Todos[0].ID != "" {
+		t.Errorf("Todos = %+v", card.Todos)
+	}
+	if card.Fields["severity"] != "high" || card.Fields["version"] != "1.0" {
+		t.Errorf("Fields = %v", card.Fields)
+	}
+	if len(tmpl.Labels) != 2 {
+		t.Errorf("template labels modified: %v", tmpl.Labels)
+	}
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeEntry is time logged on a card, either by a timer or entered manually.
+// A timer entry is running while End is zero.
+type TimeEntry struct {
+	ID    string    `json:"id" yaml:"id"`
+	User  string    `json:"user" yaml:"user"`
+	Start time.Time `json:"start" yaml:"start"`
+	End   time.Time `json:"end,omitempty" yaml:"end,omitempty"`
+	Note  string    `json:"note,omitempty" yaml:"note,omitempty"`
+}
+
+func (e *TimeEntry) Validate() error {
+	if e.User == "" {
+		return &ErrValidation{Field: "user", Message: "is required"}
+	}
+	if !e.End.IsZero() && e.End.Before(e.Start) {
+		return &ErrValidation{Field: "end", Message: "must not be before start"}
+	}
+	return nil
+}
+
+func (e *TimeEntry) Running() bool {
+	return e.End.IsZero()
+}
+
+// Duration returns the logged time, counting a running entry up to now.
+func (e *TimeEntry) Duration(now time.Time) time.Duration {
+	if e.Running() {
+		return now.Sub(e.Start)
+	}
+	return e.End.Sub(e.Start)
+}
+
+// RunningEntry returns the index of user's running timer on the card, or -1.
+func (c *Card) RunningEntry(user string) int {
+	for i, e := range c.TimeEntries {
+		if e.User == user && e.Running() {
+			return i
+		}
+	}
+	return -1
+}
+
+// CardTime is logged time against the estimate for one card.
+type CardTime struct {
+	CardID          string `json:"card_id"`
+	Title           string `json:"title"`
+	EstimateMinutes int    `json:"estimate_minutes"`
+	LoggedMinutes   int    `json:"logged_minutes"`
+}
+
+type LabelTime struct {
+	Label         string `json:"label"`
+	LoggedMinutes int    `json:"logged_minutes"`
+}
+
+type WeekTime struct {
+	// Week is an ISO week such as "2026-W04".
+	Week          string `json:"week"`
+	LoggedMinutes int    `json:"logged_minutes"`
+}
+
+// TimeReport summarizes logged time on a board.
+type TimeReport struct {
+	BoardID         string      `json:"board_id"`
+	EstimateMinutes int         `json:"estimate_minutes"`
+	LoggedMinutes   int         `json:"logged_minutes"`
+	ByCard          []CardTime  `json:"by_card"`
+	ByLabel         []LabelTime `json:"by_label"`
+	ByWeek          []WeekTime  `json:"by_week"`
+}
+
+// ISOWeek formats the ISO week containing t, e.g. "2026-W04".
+func ISOWeek(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"regexp"
+	"time"
+)
+
+// TrashKind is the kind of item held in the trash.
+type TrashKind string
+
+const (
+	TrashBoard TrashKind = "board"
+	TrashCard  TrashKind = "card"
+)
+
+// TrashItem is a deleted board or card that can still be restored. A trashed
+// board carries its cards, comments and attachments with it.
+type TrashItem struct {
+	ID      string    `json:"id" yaml:"-"`
+	Kind    TrashKind `json:"kind" yaml:"kind"`
+	BoardID string    `json:"board_id" yaml:"board_id"`
+	CardID  string    `json:"card_id,omitempty" yaml:"card_id,omitempty"`
+	// Name is the board name or the card title at deletion time.
+	Name      string    `json:"name" yaml:"name"`
+	DeletedAt time.Time `json:"deleted_at" yaml:"deleted_at"`
+	// ExpiresAt is when the item is purged for good; it is set by the usecase
+	// from the retention period and not stored.
+	ExpiresAt time.Time `json:"expires_at" yaml:"-"`
+}
+
+// TrashEntry is a trashed item together with what it holds, in a form that
+// any storage can take, for copying the trash between storages. Board is set
+// for a trashed board, and Cards are its cards or the trashed card alone.
+type TrashEntry struct {
+	Item  TrashItem
+	Board *Board
+	Cards []Card
+}
+
+// trashItemIDPattern is the form of the IDs the stores give trash items: the
+// deletion time followed by random hex digits.
+var trashItemIDPattern = regexp.MustCompile(`^[0-9]{8}-[0-9]{6}-[0-9a-f]{8}$`)
+
+// ValidateTrashItemID checks that id has the form of a generated trash item
+// ID, so that one taken from a request cannot name a path outside the trash.
+func ValidateTrashItemID(id string) error {
+	if !trashItemIDPattern.MatchString(id) {
+		return &ErrValidation{Field: "id", Message: "is not a trash item id"}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+// View is a named, saved card query on a board.
+type View struct {
+	ID     string     `json:"id" yaml:"id"`
+	Name   string     `json:"name" yaml:"name"`
+	Filter ViewFilter `json:"filter" yaml:"filter"`
+	// Sort is a card sort field such as "updated_at"; empty means board position.
+	Sort      string `json:"sort,omitempty" yaml:"sort,omitempty"`
+	Direction string `json:"direction,omitempty" yaml:"direction,omitempty"`
+	// Lists limits the view to the given lists; empty shows every list.
+	Lists []string `json:"lists,omitempty" yaml:"lists,omitempty"`
+}
+
+// ViewFilter holds the card conditions of a view, mirroring the card listing
+// query parameters.
+type ViewFilter struct {
+	Archived      bool              `json:"archived,omitempty" yaml:"archived,omitempty"`
+	Labels        []string          `json:"labels,omitempty" yaml:"labels,omitempty"`
+	LabelMatch    string            `json:"label_match,omitempty" yaml:"label_match,omitempty"`
+	Text          string            `json:"text,omitempty" yaml:"text,omitempty"`
+	CreatedAfter  time.Time         `json:"created_after,omitzero" yaml:"created_after,omitempty"`
+	CreatedBefore time.Time         `json:"created_before,omitzero" yaml:"created_before,omitempty"`
+	UpdatedAfter  time.Time         `json:"updated_after,omitzero" yaml:"updated_after,omitempty"`
+	UpdatedBefore time.Time         `json:"updated_before,omitzero" yaml:"updated_before,omitempty"`
+	Fields        map[string]string `json:"fields,omitempty" yaml:"fields,omitempty"`
+}
+
+func (v *View) Validate() error {
+	if v.ID == "" {
+		return &ErrValidation{Field: "id", Message: "is required"}
+	}
+	if v.Name == "" {
+		return &ErrValidation{Field: "name", Message: "is required"}
+	}
+	switch v.Direction {
+	case "", "asc", "desc":
+	default:
+		return &ErrValidation{Field: "direction", Message: "must be asc or desc"}
+	}
+	return nil
+}
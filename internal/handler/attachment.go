@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+// multipartOverhead is the allowance for multipart headers and boundaries on top
+// of the attachment size limit.
+const multipartOverhead = 1 << 20
+
+type AttachmentHandler struct {
+	uc *usecase.AttachmentUseCase
+}
+
+func NewAttachmentHandler(uc *usecase.AttachmentUseCase) *AttachmentHandler {
+	return &AttachmentHandler{uc: uc}
+}
+
+func (h *AttachmentHandler) Register(r chi.Router) {
+	r.Post("/api/boards/{id}/cards/{cardId}/attachments", h.upload)
+	r.Get("/api/boards/{id}/cards/{cardId}/attachments/{attachmentId}", h.download)
+	r.Delete("/api/boards/{id}/cards/{cardId}/attachments/{attachmentId}", h.delete)
+}
+
+func (h *AttachmentHandler) upload(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.uc.MaxSize()+multipartOverhead)
+	mr, err := r.MultipartReader()
+	if err != nil {
+		writeBadRequest(w, "multipart/form-data body is required")
+		return
+	}
+
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			writeBadRequest(w, "file is required")
+			return
+		}
+		if err != nil {
+			writeBadRequest(w, "invalid multipart body")
+			return
+		}
+		if part.FormName() != "file" {
+			continue
+		}
+
+		attachment, err := h.uc.Upload(r.Context(), boardID, cardID, part.FileName(), part.Header.Get("Content-Type"), part)
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				writeBadRequest(w, "request body too large")
+				return
+			}
+			writeError(w, err)
+			return
+		}
+		respondJSON(w, http.StatusCreated, attachment)
+		return
+	}
+}
+
+func (h *AttachmentHandler) download(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+	attachmentID := chi.URLParam(r, "attachmentId")
+
+	attachment, content, err := h.uc.Open(r.Context(), boardID, cardID, attachmentID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, content); err != nil {
+		slog.Error("failed to write attachment", "board_id", boardID, "card_id", cardID, "attachment_id", attachmentID, "error", err)
+	}
+}
+
+func (h *AttachmentHandler) delete(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+	attachmentID := chi.URLParam(r, "attachmentId")
+
+	if err := h.uc.Delete(r.Context(), boardID, cardID, attachmentID); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,156 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/handler"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type mockAttachmentRepo struct {
+	files map[string][]byte
+}
+
+func (m *mockAttachmentRepo) Put(_ context.Context, _, _, attachmentID string, r io.Reader) (int64, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	m.files[attachmentID] = data
+	return int64(len(data)), nil
+}
+
+func (m *mockAttachmentRepo) Open(_ context.Context, _, _, attachmentID string) (io.ReadCloser, error) {
+	data, ok := m.files[attachmentID]
+	if !ok {
+		return nil, &domain.ErrNotFound{Resource: "attachment", ID: attachmentID}
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *mockAttachmentRepo) Delete(_ context.Context, _, _, attachmentID string) error {
+	delete(m.files, attachmentID)
+	return nil
+}
+
+func newAttachmentRouter(attachmentRepo *mockAttachmentRepo, cardRepo *mockCardRepo) *chi.Mux {
+	uc := usecase.NewAttachmentUseCase(attachmentRepo, usecase.NewCardUseCase(cardRepo, &mockBoardRepo{}), 1024)
+	h := handler.NewAttachmentHandler(uc)
+	r := chi.NewRouter()
+	h.Register(r)
+	return r
+}
+
+func multipartBody(t *testing.T, field, filename, content string) (*bytes.Buffer, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fw, err := mw.CreateFormFile(field, filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fw.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf, mw.FormDataContentType()
+}
+
+func TestAttachmentHandler_Upload(t *testing.T) {
+	tests := []struct {
+		name       string
+		field      string
+		wantStatus int
+	}{
+		{"success", "file", http.StatusCreated},
+		{"missing file field", "other", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1", Title: "Test", List: "todo"}}
+			r := newAttachmentRouter(&mockAttachmentRepo{files: map[string][]byte{}}, cardRepo)
+
+			body, contentType := multipartBody(t, tt.field, "log.txt", "hello")
+			req := httptest.NewRequest(http.MethodPost, "/api/boards/board-1/cards/card-1/attachments", body)
+			req.Header.Set("Content-Type", contentType)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d. body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAttachmentHandler_Upload_NotMultipart(t *testing.T) {
+	cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1", Title: "Test", List: "todo"}}
+	r := newAttachmentRouter(&mockAttachmentRepo{files: map[string][]byte{}}, cardRepo)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/boards/board-1/cards/card-1/attachments", bytes.NewBufferString("{}"))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestAttachmentHandler_Download(t *testing.T) {
+	cardRepo := &mockCardRepo{card: &domain.Card{
+		ID: "card-1", Title: "Test", List: "todo",
+		Attachments: []domain.Attachment{{ID: "a1", Filename: "log.txt", Size: 5, ContentType: "text/plain"}},
+	}}
+	attachmentRepo := &mockAttachmentRepo{files: map[string][]byte{"a1": []byte("hello")}}
+	r := newAttachmentRouter(attachmentRepo, cardRepo)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/boards/board-1/cards/card-1/attachments/a1", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if w.Body.String() != "hello" {
+		t.Errorf("body = %q, want hello", w.Body.String())
+	}
+	if got := w.Header().Get("Content-Disposition"); got != "attachment; filename=log.txt" {
+		t.Errorf("Content-Disposition = %q", got)
+	}
+}
+
+func TestAttachmentHandler_Delete(t *testing.T) {
+	cardRepo := &mockCardRepo{card: &domain.Card{
+		ID: "card-1", Title: "Test", List: "todo",
+		Attachments: []domain.Attachment{{ID: "a1", Filename: "log.txt"}},
+	}}
+	r := newAttachmentRouter(&mockAttachmentRepo{files: map[string][]byte{"a1": nil}}, cardRepo)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/boards/board-1/cards/card-1/attachments/a1", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNoContent)
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/boards/board-1/cards/card-1/attachments/a1", http.NoBody)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
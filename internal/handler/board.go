@@ -11,11 +11,13 @@ import (
 )
 
 type BoardHandler struct {
-	uc *usecase.BoardUseCase
+	uc        *usecase.BoardUseCase
+	templates *usecase.BoardTemplateUseCase
+	clone     *usecase.BoardCloneUseCase
 }
 
-func NewBoardHandler(uc *usecase.BoardUseCase) *BoardHandler {
-	return &BoardHandler{uc: uc}
+func NewBoardHandler(uc *usecase.BoardUseCase, templates *usecase.BoardTemplateUseCase, clone *usecase.BoardCloneUseCase) *BoardHandler {
+	return &BoardHandler{uc: uc, templates: templates, clone: clone}
 }
 
 func (h *BoardHandler) Register(r chi.Router) {
@@ -24,10 +26,14 @@ func (h *BoardHandler) Register(r chi.Router) {
 	r.Get("/api/boards/{id}", h.get)
 	r.Put("/api/boards/{id}", h.update)
 	r.Delete("/api/boards/{id}", h.delete)
+	r.Patch("/api/boards/{id}/archive", h.archive)
+	r.Post("/api/boards/{id}/clone", h.cloneBoard)
+	r.Get("/api/board-templates", h.listTemplates)
+	r.Get("/api/board-templates/{templateId}", h.getTemplate)
 }
 
 func (h *BoardHandler) list(w http.ResponseWriter, r *http.Request) {
-	boards, err := h.uc.List(r.Context())
+	boards, err := h.uc.List(r.Context(), r.URL.Query().Get("archived") == "true")
 	if err != nil {
 		writeError(w, err)
 		return
@@ -42,7 +48,13 @@ func (h *BoardHandler) create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	created, err := h.uc.Create(r.Context(), &board)
+	var created *domain.Board
+	var err error
+	if templateID := r.URL.Query().Get("template"); templateID != "" {
+		created, err = h.templates.CreateBoard(r.Context(), templateID, &board)
+	} else {
+		created, err = h.uc.Create(r.Context(), &board)
+	}
 	if err != nil {
 		writeError(w, err)
 		return
@@ -85,3 +97,64 @@ func (h *BoardHandler) delete(w http.ResponseWriter, r *http.Request) {
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (h *BoardHandler) archive(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var req archiveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	board, err := h.uc.Archive(r.Context(), id, req.Archived)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, board)
+}
+
+type cloneRequest struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Cards     bool   `json:"cards"`
+	Labels    bool   `json:"labels"`
+	Templates bool   `json:"templates"`
+}
+
+func (h *BoardHandler) cloneBoard(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var req cloneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	opts := usecase.CloneOptions{Cards: req.Cards, Labels: req.Labels, Templates: req.Templates}
+	board, err := h.clone.Clone(r.Context(), id, req.ID, req.Name, opts)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusCreated, board)
+}
+
+func (h *BoardHandler) listTemplates(w http.ResponseWriter, r *http.Request) {
+	templates, err := h.templates.List(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, templates)
+}
+
+func (h *BoardHandler) getTemplate(w http.ResponseWriter, r *http.Request) {
+	template, err := h.templates.Get(r.Context(), chi.URLParam(r, "templateId"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, template)
+}
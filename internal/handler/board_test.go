@@ -46,9 +46,28 @@ func (m *mockBoardRepo) Delete(_ context.Context, _ string) error {
 	return m.delErr
 }
 
+type mockBoardTemplateRepo struct {
+	templates []domain.BoardTemplate
+}
+
+func (m *mockBoardTemplateRepo) List(_ context.Context) ([]domain.BoardTemplate, error) {
+	return m.templates, nil
+}
+
+func (m *mockBoardTemplateRepo) Get(_ context.Context, templateID string) (*domain.BoardTemplate, error) {
+	for i := range m.templates {
+		if m.templates[i].ID == templateID {
+			return &m.templates[i], nil
+		}
+	}
+	return nil, &domain.ErrNotFound{Resource: "board template", ID: templateID}
+}
+
 func newBoardRouter(repo *mockBoardRepo) *chi.Mux {
 	uc := usecase.NewBoardUseCase(repo)
-	h := handler.NewBoardHandler(uc)
+	templateUC := usecase.NewBoardTemplateUseCase(&mockBoardTemplateRepo{}, uc)
+	cloneUC := usecase.NewBoardCloneUseCase(repo, &mockCardRepo{}, &mockTemplateRepo{}, &mockViewRepo{})
+	h := handler.NewBoardHandler(uc, templateUC, cloneUC)
 	r := chi.NewRouter()
 	h.Register(r)
 	return r
@@ -206,3 +225,120 @@ func TestBoardHandler_Delete_NotFound(t *testing.T) {
 		t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
 	}
 }
+
+func TestBoardHandler_CreateFromTemplate(t *testing.T) {
+	repo := &mockBoardRepo{}
+	r := newBoardRouter(repo)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/boards?template=kanban", bytes.NewBufferString(`{"id":"new","name":"New"}`))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d. body: %s", w.Code, http.StatusCreated, w.Body.String())
+	}
+	var board domain.Board
+	if err := json.NewDecoder(w.Body).Decode(&board); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(board.Lists) != 3 {
+		t.Errorf("got %d lists, want 3", len(board.Lists))
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/boards?template=nope", bytes.NewBufferString(`{"id":"new","name":"New"}`))
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("unknown template status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestBoardHandler_TemplatePath(t *testing.T) {
+	repo := &mockBoardRepo{}
+	uc := usecase.NewBoardUseCase(repo)
+	// A repository that resolved the ID as a path would find this one.
+	templates := &mockBoardTemplateRepo{templates: []domain.BoardTemplate{{ID: "../secret", Name: "Secret", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}}}
+	h := handler.NewBoardHandler(uc, usecase.NewBoardTemplateUseCase(templates, uc), usecase.NewBoardCloneUseCase(repo, &mockCardRepo{}, &mockTemplateRepo{}, &mockViewRepo{}))
+	r := chi.NewRouter()
+	h.Register(r)
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodPost, "/api/boards?template=../secret", bytes.NewBufferString(`{"id":"new","name":"New"}`)),
+		httptest.NewRequest(http.MethodPost, "/api/boards?template=..%2Fsecret", bytes.NewBufferString(`{"id":"new","name":"New"}`)),
+		httptest.NewRequest(http.MethodGet, "/api/board-templates/..%2Fsecret", http.NoBody),
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s %s status = %d, want %d", req.Method, req.URL, w.Code, http.StatusBadRequest)
+		}
+	}
+	if repo.board != nil {
+		t.Errorf("board created: %+v", repo.board)
+	}
+}
+
+func TestBoardHandler_Templates(t *testing.T) {
+	r := newBoardRouter(&mockBoardRepo{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/board-templates", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	var templates []domain.BoardTemplate
+	if err := json.NewDecoder(w.Body).Decode(&templates); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(templates) != len(domain.BuiltinBoardTemplates()) {
+		t.Errorf("got %d templates, want the built-in ones", len(templates))
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/board-templates/missing", http.NoBody)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestBoardHandler_Clone(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{name: "success", body: `{"id":"copy","name":"Copy","cards":true}`, wantStatus: http.StatusCreated},
+		{name: "missing id", body: `{"name":"Copy"}`, wantStatus: http.StatusBadRequest},
+		{name: "invalid body", body: `{`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockBoardRepo{board: &domain.Board{ID: "src", Name: "Src", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}}
+			r := newBoardRouter(repo)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/boards/src/clone", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d. body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestBoardHandler_Archive(t *testing.T) {
+	repo := &mockBoardRepo{board: &domain.Board{ID: "test", Name: "Test", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}}
+	r := newBoardRouter(repo)
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/boards/test/archive", bytes.NewBufferString(`{"archived":true}`))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d. body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	if !repo.board.Archived {
+		t.Error("board was not archived")
+	}
+}
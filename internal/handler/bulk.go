@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type bulkRequest struct {
+	Operations []usecase.BulkOperation `json:"operations"`
+}
+
+type bulkResponse struct {
+	Results []usecase.BulkResult `json:"results"`
+}
+
+// bulkErrorBody is an error that comes with the per-card results explaining it.
+type bulkErrorBody struct {
+	Error   errorDetail          `json:"error"`
+	Results []usecase.BulkResult `json:"results"`
+}
+
+func (h *CardHandler) bulk(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+
+	var req bulkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	results, err := h.uc.Bulk(r.Context(), boardID, req.Operations)
+	if err != nil {
+		var validation *domain.ErrValidation
+		if errors.As(err, &validation) && results != nil {
+			respondJSON(w, http.StatusBadRequest, bulkErrorBody{
+				Error:   errorDetail{Code: "validation_error", Message: err.Error()},
+				Results: results,
+			})
+			return
+		}
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, bulkResponse{Results: results})
+}
@@ -0,0 +1,97 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+type bulkResponseBody struct {
+	Error *struct {
+		Code string `json:"code"`
+	} `json:"error"`
+	Results []struct {
+		CardID string       `json:"card_id"`
+		Action string       `json:"action"`
+		Card   *domain.Card `json:"card"`
+		Error  string       `json:"error"`
+	} `json:"results"`
+}
+
+func newBulkRouter() (*mockCardRepo, http.Handler) {
+	boardRepo := &mockBoardRepo{
+		board: &domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}, {ID: "done", Name: "Done"}}},
+	}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "A", List: "todo", Rank: "a"},
+		{ID: "card-2", Title: "B", List: "todo", Rank: "b"},
+	}}
+	return cardRepo, newCardRouter(cardRepo, boardRepo)
+}
+
+func TestCardHandler_Bulk(t *testing.T) {
+	_, r := newBulkRouter()
+
+	body := `{"operations":[{"action":"move","card_ids":["card-1","card-2"],"list":"done"},{"action":"add_label","card_ids":["card-1"],"label":"bug"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/boards/board-1/cards/bulk", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d. body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var resp bulkResponseBody
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(resp.Results) != 3 {
+		t.Fatalf("results = %d, want 3", len(resp.Results))
+	}
+	if got := resp.Results[2].Card; got == nil || got.List != "done" || len(got.Labels) != 1 {
+		t.Errorf("card-1 = %+v", got)
+	}
+}
+
+func TestCardHandler_Bulk_CardErrors(t *testing.T) {
+	cardRepo, r := newBulkRouter()
+
+	body := `{"operations":[{"action":"archive","card_ids":["card-1","missing"]}]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/boards/board-1/cards/bulk", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+	var resp bulkResponseBody
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if resp.Error == nil || resp.Error.Code != "validation_error" {
+		t.Errorf("error = %+v", resp.Error)
+	}
+	if len(resp.Results) != 2 || resp.Results[0].Error != "" || resp.Results[1].Error == "" {
+		t.Errorf("results = %+v", resp.Results)
+	}
+	if cardRepo.card != nil {
+		t.Error("expected nothing to be saved")
+	}
+}
+
+func TestCardHandler_Bulk_InvalidRequest(t *testing.T) {
+	_, r := newBulkRouter()
+
+	for _, body := range []string{`not json`, `{"operations":[]}`, `{"operations":[{"action":"move","card_ids":["card-1"]}]}`} {
+		req := httptest.NewRequest(http.MethodPost, "/api/boards/board-1/cards/bulk", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", body, w.Code, http.StatusBadRequest)
+		}
+	}
+}
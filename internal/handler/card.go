@@ -3,6 +3,9 @@ package handler
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 
@@ -11,33 +14,132 @@ import (
 )
 
 type CardHandler struct {
-	uc *usecase.CardUseCase
+	uc        *usecase.CardUseCase
+	templates *usecase.TemplateUseCase
 }
 
-func NewCardHandler(uc *usecase.CardUseCase) *CardHandler {
-	return &CardHandler{uc: uc}
+func NewCardHandler(uc *usecase.CardUseCase, templates *usecase.TemplateUseCase) *CardHandler {
+	return &CardHandler{uc: uc, templates: templates}
 }
 
 func (h *CardHandler) Register(r chi.Router) {
 	r.Get("/api/boards/{id}/cards", h.list)
 	r.Post("/api/boards/{id}/cards", h.create)
+	r.Post("/api/boards/{id}/cards/bulk", h.bulk)
+	r.Get("/api/boards/{id}/templates", h.listTemplates)
+	r.Get("/api/boards/{id}/templates/{templateId}", h.getTemplate)
 	r.Get("/api/boards/{id}/cards/{cardId}", h.get)
 	r.Put("/api/boards/{id}/cards/{cardId}", h.update)
 	r.Delete("/api/boards/{id}/cards/{cardId}", h.delete)
 	r.Patch("/api/boards/{id}/cards/{cardId}/move", h.move)
 	r.Patch("/api/boards/{id}/cards/{cardId}/archive", h.archive)
+	r.Post("/api/boards/{id}/cards/{cardId}/todos", h.addTodo)
+	r.Patch("/api/boards/{id}/cards/{cardId}/todos/{todoId}", h.updateTodo)
+	r.Delete("/api/boards/{id}/cards/{cardId}/todos/{todoId}", h.deleteTodo)
+	r.Post("/api/boards/{id}/cards/{cardId}/todos/{todoId}/toggle", h.toggleTodo)
+	r.Patch("/api/boards/{id}/cards/{cardId}/todos/{todoId}/move", h.moveTodo)
+	r.Post("/api/boards/{id}/cards/{cardId}/todos/{todoId}/convert", h.convertTodo)
+	r.Get("/api/boards/{id}/cards/{cardId}/children", h.children)
+	r.Get("/api/boards/{id}/cards/{cardId}/progress", h.progress)
+	r.Patch("/api/boards/{id}/cards/{cardId}/parent", h.setParent)
+	r.Post("/api/boards/{id}/cards/{cardId}/timer/start", h.startTimer)
+	r.Post("/api/boards/{id}/cards/{cardId}/timer/stop", h.stopTimer)
+	r.Post("/api/boards/{id}/cards/{cardId}/time-entries", h.logTime)
+	r.Delete("/api/boards/{id}/cards/{cardId}/time-entries/{entryId}", h.deleteTimeEntry)
+	r.Get("/api/boards/{id}/time-report", h.timeReport)
+	r.Get("/api/boards/{id}/dependencies", h.dependencies)
+	r.Post("/api/boards/{id}/cards/{cardId}/dependencies", h.addDependency)
+	r.Delete("/api/boards/{id}/cards/{cardId}/dependencies/{blockerBoard}/{blockerId}", h.removeDependency)
 }
 
 func (h *CardHandler) list(w http.ResponseWriter, r *http.Request) {
 	boardID := chi.URLParam(r, "id")
-	includeArchived := r.URL.Query().Get("archived") == "true"
+	query := r.URL.Query()
+	filter := usecase.CardFilter{
+		IncludeArchived: query.Get("archived") == "true",
+		LabelMatch:      usecase.LabelMatch(query.Get("label_match")),
+		Text:            query.Get("q"),
+	}
+	filter.Lists = splitParam(query["list"])
+	filter.Labels = splitParam(query["label"])
+	for key := range query {
+		if id, ok := strings.CutPrefix(key, "field."); ok {
+			if filter.Fields == nil {
+				filter.Fields = make(map[string]string)
+			}
+			filter.Fields[id] = query.Get(key)
+		}
+	}
+	for param, dst := range map[string]*time.Time{
+		"created_after":  &filter.CreatedAfter,
+		"created_before": &filter.CreatedBefore,
+		"updated_after":  &filter.UpdatedAfter,
+		"updated_before": &filter.UpdatedBefore,
+	} {
+		v := query.Get(param)
+		if v == "" {
+			continue
+		}
+		t, err := parseTimeParam(v)
+		if err != nil {
+			writeBadRequest(w, param+" must be RFC 3339 or YYYY-MM-DD")
+			return
+		}
+		*dst = t
+	}
 
-	cards, err := h.uc.List(r.Context(), boardID, includeArchived)
+	q := usecase.CardQuery{
+		Filter: filter,
+		Sort:   usecase.CardSort(query.Get("sort")),
+		Cursor: query.Get("cursor"),
+	}
+	switch query.Get("direction") {
+	case "", "asc":
+	case "desc":
+		q.Desc = true
+	default:
+		writeBadRequest(w, "direction must be asc or desc")
+		return
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			writeBadRequest(w, "limit must be a positive integer")
+			return
+		}
+		q.Limit = limit
+	}
+
+	page, err := h.uc.ListPage(r.Context(), boardID, q)
 	if err != nil {
 		writeError(w, err)
 		return
 	}
-	respondJSON(w, http.StatusOK, cards)
+	if page.NextCursor != "" {
+		w.Header().Set("X-Next-Cursor", page.NextCursor)
+	}
+	respondJSON(w, http.StatusOK, page.Cards)
+}
+
+// splitParam collects the comma-separated values of a repeatable parameter.
+func splitParam(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for item := range strings.SplitSeq(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				out = append(out, item)
+			}
+		}
+	}
+	return out
+}
+
+// parseTimeParam accepts an RFC 3339 timestamp or a date, read as local midnight.
+func parseTimeParam(v string) (time.Time, error) {
+	if t, err := time.ParseInLocation(time.DateOnly, v, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, v)
 }
 
 func (h *CardHandler) create(w http.ResponseWriter, r *http.Request) {
@@ -49,7 +151,13 @@ func (h *CardHandler) create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	created, err := h.uc.Create(r.Context(), boardID, &card)
+	var created *domain.Card
+	var err error
+	if templateID := r.URL.Query().Get("template"); templateID != "" {
+		created, err = h.templates.CreateCard(r.Context(), boardID, templateID, &card)
+	} else {
+		created, err = h.uc.Create(r.Context(), boardID, &card)
+	}
 	if err != nil {
 		writeError(w, err)
 		return
@@ -57,6 +165,29 @@ func (h *CardHandler) create(w http.ResponseWriter, r *http.Request) {
 	respondJSON(w, http.StatusCreated, created)
 }
 
+func (h *CardHandler) listTemplates(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+
+	templates, err := h.templates.List(r.Context(), boardID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, templates)
+}
+
+func (h *CardHandler) getTemplate(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	templateID := chi.URLParam(r, "templateId")
+
+	template, err := h.templates.Get(r.Context(), boardID, templateID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, template)
+}
+
 func (h *CardHandler) get(w http.ResponseWriter, r *http.Request) {
 	boardID := chi.URLParam(r, "id")
 	cardID := chi.URLParam(r, "cardId")
@@ -99,6 +230,8 @@ func (h *CardHandler) delete(w http.ResponseWriter, r *http.Request) {
 }
 
 type moveRequest struct {
+	// Board is the target board; empty keeps the card on its current board.
+	Board string `json:"board"`
 	List  string `json:"list"`
 	Order int    `json:"order"`
 }
@@ -118,7 +251,13 @@ func (h *CardHandler) move(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	card, err := h.uc.Move(r.Context(), boardID, cardID, req.List, req.Order)
+	var card *domain.Card
+	var err error
+	if req.Board != "" {
+		card, err = h.uc.MoveToBoard(r.Context(), boardID, cardID, req.Board, req.List, req.Order)
+	} else {
+		card, err = h.uc.Move(r.Context(), boardID, cardID, req.List, req.Order)
+	}
 	if err != nil {
 		writeError(w, err)
 		return
@@ -147,3 +286,94 @@ func (h *CardHandler) archive(w http.ResponseWriter, r *http.Request) {
 	}
 	respondJSON(w, http.StatusOK, card)
 }
+
+func (h *CardHandler) children(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+
+	children, err := h.uc.Children(r.Context(), boardID, cardID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, children)
+}
+
+func (h *CardHandler) progress(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+
+	progress, err := h.uc.Progress(r.Context(), boardID, cardID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, progress)
+}
+
+type parentRequest struct {
+	Parent string `json:"parent"`
+}
+
+func (h *CardHandler) setParent(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+
+	var req parentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	card, err := h.uc.SetParent(r.Context(), boardID, cardID, req.Parent)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, card)
+}
+
+func (h *CardHandler) dependencies(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+
+	graph, err := h.uc.DependencyGraph(r.Context(), boardID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, graph)
+}
+
+func (h *CardHandler) addDependency(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+
+	var req domain.CardRef
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	card, err := h.uc.AddDependency(r.Context(), boardID, cardID, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, card)
+}
+
+func (h *CardHandler) removeDependency(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+	blocker := domain.CardRef{
+		Board: chi.URLParam(r, "blockerBoard"),
+		ID:    chi.URLParam(r, "blockerId"),
+	}
+
+	card, err := h.uc.RemoveDependency(r.Context(), boardID, cardID, blocker)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, card)
+}
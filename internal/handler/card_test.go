@@ -37,6 +37,11 @@ func (m *mockCardRepo) Get(_ context.Context, _, cardID string) (*domain.Card, e
 	if m.card != nil && m.card.ID == cardID {
 		return m.card, nil
 	}
+	for i := range m.cards {
+		if m.cards[i].ID == cardID {
+			return &m.cards[i], nil
+		}
+	}
 	return nil, &domain.ErrNotFound{Resource: "card", ID: cardID}
 }
 
@@ -45,6 +50,15 @@ func (m *mockCardRepo) Save(_ context.Context, _ string, card *domain.Card) erro
 	return m.saveErr
 }
 
+func (m *mockCardRepo) SaveAll(ctx context.Context, writes []domain.CardWrite) error {
+	for _, w := range writes {
+		if err := m.Save(ctx, w.BoardID, w.Card); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *mockCardRepo) Delete(_ context.Context, _, _ string) error {
 	return m.delErr
 }
@@ -65,9 +79,42 @@ func (m *mockCardRepo) Create(_ context.Context, _ string, card *domain.Card) (s
 	return m.nextID, nil
 }
 
+func (m *mockCardRepo) Transfer(_ context.Context, _, _ string, card *domain.Card) (string, error) {
+	m.card = card
+	return card.ID, m.saveErr
+}
+
+type mockTemplateRepo struct {
+	templates []domain.CardTemplate
+}
+
+func (m *mockTemplateRepo) ListByBoard(_ context.Context, _ string) ([]domain.CardTemplate, error) {
+	return m.templates, nil
+}
+
+func (m *mockTemplateRepo) Get(_ context.Context, _, templateID string) (*domain.CardTemplate, error) {
+	for i := range m.templates {
+		if m.templates[i].ID == templateID {
+			t := m.templates[i]
+			return &t, nil
+		}
+	}
+	return nil, &domain.ErrNotFound{Resource: "template", ID: templateID}
+}
+
+func (m *mockTemplateRepo) Save(_ context.Context, _ string, t *domain.CardTemplate) error {
+	m.templates = append(m.templates, *t)
+	return nil
+}
+
 func newCardRouter(cardRepo *mockCardRepo, boardRepo *mockBoardRepo) *chi.Mux {
+	return newCardRouterWithTemplates(cardRepo, boardRepo, &mockTemplateRepo{})
+}
+
+func newCardRouterWithTemplates(cardRepo *mockCardRepo, boardRepo *mockBoardRepo, templateRepo *mockTemplateRepo) *chi.Mux {
 	uc := usecase.NewCardUseCase(cardRepo, boardRepo)
-	h := handler.NewCardHandler(uc)
+	templateUC := usecase.NewTemplateUseCase(templateRepo, boardRepo, uc)
+	h := handler.NewCardHandler(uc, templateUC)
 	r := chi.NewRouter()
 	h.Register(r)
 	return r
@@ -363,6 +410,90 @@ func TestCardHandler_Archive_NotFound(t *testing.T) {
 	}
 }
 
+func TestCardHandler_List_Query(t *testing.T) {
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "B", List: "todo", Labels: []string{"auth"}},
+		{ID: "card-2", Title: "A", List: "todo", Labels: []string{"auth"}},
+		{ID: "card-3", Title: "C", List: "todo"},
+	}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	get := func(url string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, http.NoBody)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("/api/boards/board-1/cards?label=auth&sort=title&direction=desc&limit=1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d. body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	var cards []domain.Card
+	if err := json.NewDecoder(w.Body).Decode(&cards); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(cards) != 1 || cards[0].ID != "card-1" {
+		t.Fatalf("first page = %+v", cards)
+	}
+	cursor := w.Header().Get("X-Next-Cursor")
+	if cursor == "" {
+		t.Fatal("expected X-Next-Cursor header")
+	}
+
+	w = get("/api/boards/board-1/cards?label=auth&sort=title&direction=desc&limit=1&cursor=" + cursor)
+	cards = nil
+	if err := json.NewDecoder(w.Body).Decode(&cards); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(cards) != 1 || cards[0].ID != "card-2" {
+		t.Errorf("second page = %+v", cards)
+	}
+	if w.Header().Get("X-Next-Cursor") != "" {
+		t.Error("expected no X-Next-Cursor on the last page")
+	}
+
+	for _, url := range []string{
+		"/api/boards/board-1/cards?direction=up",
+		"/api/boards/board-1/cards?limit=0",
+		"/api/boards/board-1/cards?created_after=yesterday",
+		"/api/boards/board-1/cards?sort=priority",
+	} {
+		if w := get(url); w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", url, w.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestCardHandler_Move_ToBoard(t *testing.T) {
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-2", Lists: []domain.List{{ID: "backlog", Name: "Backlog"}}}}
+	cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1", Title: "Test", List: "todo"}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{name: "success", body: `{"board":"board-2","list":"backlog","order":0}`, wantStatus: http.StatusOK},
+		{name: "board not found", body: `{"board":"board-9","list":"backlog"}`, wantStatus: http.StatusNotFound},
+		{name: "list not on target", body: `{"board":"board-2","list":"todo"}`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, "/api/boards/board-1/cards/card-1/move", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d. body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
 func TestCardHandler_List_BoardNotFound(t *testing.T) {
 	boardRepo := &mockBoardRepo{}
 	cardRepo := &mockCardRepo{}
@@ -401,3 +532,145 @@ func TestCardHandler_List_WithArchived(t *testing.T) {
 		t.Errorf("got %d cards, want 2", len(cards))
 	}
 }
+
+func TestCardHandler_AddDependency(t *testing.T) {
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1"}}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "A", List: "todo"},
+		{ID: "card-2", Title: "B", List: "todo"},
+	}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	body := `{"id":"card-2"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/boards/board-1/cards/card-1/dependencies", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d. body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+}
+
+func TestCardHandler_RemoveDependency_NotFound(t *testing.T) {
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1"}}
+	cardRepo := &mockCardRepo{cards: []domain.Card{{ID: "card-1", Title: "A", List: "todo"}}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/boards/board-1/cards/card-1/dependencies/board-1/card-2", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestCardHandler_Dependencies(t *testing.T) {
+	board := domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}
+	boardRepo := &mockBoardRepo{board: &board, boards: []domain.Board{board}}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "A", List: "todo", BlockedBy: []domain.CardRef{{Board: "board-1", ID: "card-2"}}},
+		{ID: "card-2", Title: "B", List: "todo"},
+	}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/boards/board-1/dependencies", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var graph domain.DependencyGraph
+	if err := json.NewDecoder(w.Body).Decode(&graph); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(graph.Edges) != 1 {
+		t.Errorf("got %d edges, want 1", len(graph.Edges))
+	}
+}
+
+func TestCardHandler_Children(t *testing.T) {
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1"}}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "epic", Title: "Epic", List: "todo"},
+		{ID: "sub", Title: "Sub", List: "todo", Parent: "epic"},
+	}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/boards/board-1/cards/epic/children", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var cards []domain.Card
+	if err := json.NewDecoder(w.Body).Decode(&cards); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(cards) != 1 {
+		t.Errorf("got %d cards, want 1", len(cards))
+	}
+}
+
+func TestCardHandler_SetParent(t *testing.T) {
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1"}}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "epic", Title: "Epic", List: "todo"},
+		{ID: "sub", Title: "Sub", List: "todo"},
+	}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	body := `{"parent":"epic"}`
+	req := httptest.NewRequest(http.MethodPatch, "/api/boards/board-1/cards/sub/parent", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d. body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+}
+
+func TestCardHandler_Progress(t *testing.T) {
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1"}}
+	cardRepo := &mockCardRepo{cards: []domain.Card{{ID: "epic", Title: "Epic", List: "todo"}}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/boards/board-1/cards/epic/progress", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestCardHandler_List_FieldFilter(t *testing.T) {
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1", Fields: []domain.FieldDef{
+		{ID: "severity", Name: "Severity", Type: domain.FieldEnum, Options: []string{"low", "high"}},
+	}}}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "A", List: "todo", Fields: map[string]any{"severity": "high"}},
+		{ID: "card-2", Title: "B", List: "todo", Fields: map[string]any{"severity": "low"}},
+	}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/boards/board-1/cards?field.severity=high", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var cards []domain.Card
+	if err := json.NewDecoder(w.Body).Decode(&cards); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(cards) != 1 || cards[0].ID != "card-1" {
+		t.Errorf("got %v, want only card-1", cards)
+	}
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type CommentHandler struct {
+	uc *usecase.CommentUseCase
+}
+
+func NewCommentHandler(uc *usecase.CommentUseCase) *CommentHandler {
+	return &CommentHandler{uc: uc}
+}
+
+func (h *CommentHandler) Register(r chi.Router) {
+	r.Get("/api/boards/{id}/cards/{cardId}/comments", h.list)
+	r.Post("/api/boards/{id}/cards/{cardId}/comments", h.create)
+	r.Put("/api/boards/{id}/cards/{cardId}/comments/{commentId}", h.update)
+	r.Delete("/api/boards/{id}/cards/{cardId}/comments/{commentId}", h.delete)
+}
+
+func (h *CommentHandler) list(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+
+	comments, err := h.uc.List(r.Context(), boardID, cardID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, comments)
+}
+
+func (h *CommentHandler) create(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+
+	var comment domain.Comment
+	if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	created, err := h.uc.Create(r.Context(), boardID, cardID, &comment)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusCreated, created)
+}
+
+type commentUpdateRequest struct {
+	Body string `json:"body"`
+}
+
+func (h *CommentHandler) update(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+	commentID := chi.URLParam(r, "commentId")
+
+	var req commentUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	updated, err := h.uc.Update(r.Context(), boardID, cardID, commentID, req.Body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, updated)
+}
+
+func (h *CommentHandler) delete(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+	commentID := chi.URLParam(r, "commentId")
+
+	if err := h.uc.Delete(r.Context(), boardID, cardID, commentID); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,139 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/handler"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type mockCommentRepo struct {
+	comments []domain.Comment
+}
+
+func (m *mockCommentRepo) ListByCard(_ context.Context, _, _ string) ([]domain.Comment, error) {
+	return m.comments, nil
+}
+
+func (m *mockCommentRepo) Get(_ context.Context, _, _, commentID string) (*domain.Comment, error) {
+	for i := range m.comments {
+		if m.comments[i].ID == commentID {
+			return &m.comments[i], nil
+		}
+	}
+	return nil, &domain.ErrNotFound{Resource: "comment", ID: commentID}
+}
+
+func (m *mockCommentRepo) Create(_ context.Context, _, _ string, comment *domain.Comment) (string, error) {
+	comment.ID = "1"
+	m.comments = append(m.comments, *comment)
+	return comment.ID, nil
+}
+
+func (m *mockCommentRepo) Save(_ context.Context, _, _ string, _ *domain.Comment) error {
+	return nil
+}
+
+func (m *mockCommentRepo) Delete(_ context.Context, _, _, _ string) error {
+	return nil
+}
+
+func newCommentRouter(commentRepo *mockCommentRepo, cardRepo *mockCardRepo) *chi.Mux {
+	uc := usecase.NewCommentUseCase(commentRepo, cardRepo)
+	h := handler.NewCommentHandler(uc)
+	r := chi.NewRouter()
+	h.Register(r)
+	return r
+}
+
+func TestCommentHandler_List(t *testing.T) {
+	commentRepo := &mockCommentRepo{comments: []domain.Comment{{ID: "1", Author: "alice", Body: "hi"}}}
+	cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1"}}
+	r := newCommentRouter(commentRepo, cardRepo)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/boards/board-1/cards/card-1/comments", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var comments []domain.Comment
+	if err := json.NewDecoder(w.Body).Decode(&comments); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(comments) != 1 {
+		t.Errorf("got %d comments, want 1", len(comments))
+	}
+}
+
+func TestCommentHandler_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{"success", `{"author":"alice","body":"hi"}`, http.StatusCreated},
+		{"missing body", `{"author":"alice"}`, http.StatusBadRequest},
+		{"invalid body", `invalid`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1"}}
+			r := newCommentRouter(&mockCommentRepo{}, cardRepo)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/boards/board-1/cards/card-1/comments", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d. body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestCommentHandler_Update(t *testing.T) {
+	commentRepo := &mockCommentRepo{comments: []domain.Comment{{ID: "1", Author: "alice", Body: "hi"}}}
+	r := newCommentRouter(commentRepo, &mockCardRepo{})
+
+	body := `{"body":"edited"}`
+	req := httptest.NewRequest(http.MethodPut, "/api/boards/board-1/cards/card-1/comments/1", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d. body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+}
+
+func TestCommentHandler_Delete(t *testing.T) {
+	commentRepo := &mockCommentRepo{comments: []domain.Comment{{ID: "1", Author: "alice", Body: "hi"}}}
+	r := newCommentRouter(commentRepo, &mockCardRepo{})
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/boards/board-1/cards/card-1/comments/1", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNoContent)
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/boards/board-1/cards/card-1/comments/missing", http.NoBody)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type RecurringHandler struct {
+	uc *usecase.RecurringUseCase
+}
+
+func NewRecurringHandler(uc *usecase.RecurringUseCase) *RecurringHandler {
+	return &RecurringHandler{uc: uc}
+}
+
+func (h *RecurringHandler) Register(r chi.Router) {
+	r.Get("/api/boards/{id}/recurring", h.list)
+	r.Put("/api/boards/{id}/recurring/{recurringId}", h.save)
+}
+
+func (h *RecurringHandler) list(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+
+	items, err := h.uc.List(r.Context(), boardID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, items)
+}
+
+func (h *RecurringHandler) save(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+
+	var rc domain.RecurringCard
+	if err := json.NewDecoder(r.Body).Decode(&rc); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+	rc.ID = chi.URLParam(r, "recurringId")
+
+	saved, err := h.uc.Save(r.Context(), boardID, &rc)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, saved)
+}
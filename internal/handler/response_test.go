@@ -8,11 +8,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
-	"github.com/go-chi/chi/v5"
-
 	"github.com/hiroto-aibara/secretary-ai/internal/domain"
-	"github.com/hiroto-aibara/secretary-ai/internal/handler"
-	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
 )
 
 func TestWriteError_NotFound(t *testing.T) {
@@ -46,10 +42,7 @@ func TestWriteError_Conflict(t *testing.T) {
 		board: &domain.Board{ID: "existing", Name: "Existing", Lists: []domain.List{{ID: "todo", Name: "Todo"}}},
 	}
 
-	uc := usecase.NewBoardUseCase(repo)
-	h := handler.NewBoardHandler(uc)
-	r := chi.NewRouter()
-	h.Register(r)
+	r := newBoardRouter(repo)
 
 	body := `{"id":"existing","name":"Conflict","lists":[{"id":"todo","name":"Todo"}]}`
 	req := httptest.NewRequest(http.MethodPost, "/api/boards", bytes.NewBufferString(body))
@@ -67,10 +60,7 @@ func TestWriteError_InternalError(t *testing.T) {
 		saveErr: fmt.Errorf("disk full"),
 	}
 
-	uc := usecase.NewBoardUseCase(repo)
-	h := handler.NewBoardHandler(uc)
-	r := chi.NewRouter()
-	h.Register(r)
+	r := newBoardRouter(repo)
 
 	body := `{"name":"Updated"}`
 	req := httptest.NewRequest(http.MethodPut, "/api/boards/test", bytes.NewBufferString(body))
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type SearchHandler struct {
+	uc *usecase.SearchUseCase
+}
+
+func NewSearchHandler(uc *usecase.SearchUseCase) *SearchHandler {
+	return &SearchHandler{uc: uc}
+}
+
+func (h *SearchHandler) Register(r chi.Router) {
+	r.Get("/api/search", h.search)
+}
+
+func (h *SearchHandler) search(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	q := usecase.SearchQuery{
+		Text:            query.Get("q"),
+		IncludeArchived: query.Get("archived") == "true",
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			writeBadRequest(w, "limit must be a positive integer")
+			return
+		}
+		q.Limit = limit
+	}
+
+	hits, err := h.uc.Search(r.Context(), q)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, hits)
+}
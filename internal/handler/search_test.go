@@ -0,0 +1,64 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/handler"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+func TestSearchHandler_Search(t *testing.T) {
+	board := domain.Board{ID: "board-1"}
+	boardRepo := &mockBoardRepo{boards: []domain.Board{board}, board: &board}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "Fix login bug", List: "todo"},
+		{ID: "card-2", Title: "Write docs", List: "todo"},
+	}}
+	uc := usecase.NewSearchUseCase(cardRepo, boardRepo)
+	if err := uc.Rebuild(context.Background()); err != nil {
+		t.Fatalf("rebuild: %v", err)
+	}
+	r := chi.NewRouter()
+	handler.NewSearchHandler(uc).Register(r)
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		wantHits   int
+	}{
+		{name: "match", url: "/api/search?q=login", wantStatus: http.StatusOK, wantHits: 1},
+		{name: "no match", url: "/api/search?q=nothing", wantStatus: http.StatusOK, wantHits: 0},
+		{name: "missing q", url: "/api/search", wantStatus: http.StatusBadRequest},
+		{name: "invalid limit", url: "/api/search?q=login&limit=0", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d. body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var hits []domain.SearchHit
+			if err := json.NewDecoder(w.Body).Decode(&hits); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if len(hits) != tt.wantHits {
+				t.Errorf("hits = %d, want %d", len(hits), tt.wantHits)
+			}
+		})
+	}
+}
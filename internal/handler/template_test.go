@@ -0,0 +1,120 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func newTemplateFixture() (*mockCardRepo, *mockBoardRepo, *mockTemplateRepo) {
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}}
+	cardRepo := &mockCardRepo{nextID: "20260124-001"}
+	templateRepo := &mockTemplateRepo{templates: []domain.CardTemplate{{
+		ID:          "bug",
+		Name:        "Bug report",
+		List:        "todo",
+		Description: "## Steps to reproduce",
+		Labels:      []string{"bug"},
+		Todos:       []domain.TodoItem{{Text: "Reproduce"}},
+	}}}
+	return cardRepo, boardRepo, templateRepo
+}
+
+func TestCardHandler_ListTemplates(t *testing.T) {
+	cardRepo, boardRepo, templateRepo := newTemplateFixture()
+	r := newCardRouterWithTemplates(cardRepo, boardRepo, templateRepo)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/boards/board-1/templates", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var templates []domain.CardTemplate
+	if err := json.NewDecoder(w.Body).Decode(&templates); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(templates) != 1 || templates[0].ID != "bug" {
+		t.Errorf("templates = %+v", templates)
+	}
+}
+
+func TestCardHandler_GetTemplate_NotFound(t *testing.T) {
+	cardRepo, boardRepo, templateRepo := newTemplateFixture()
+	r := newCardRouterWithTemplates(cardRepo, boardRepo, templateRepo)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/boards/board-1/templates/missing", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestCardHandler_Create_WithTemplate(t *testing.T) {
+	cardRepo, boardRepo, templateRepo := newTemplateFixture()
+	r := newCardRouterWithTemplates(cardRepo, boardRepo, templateRepo)
+
+	body := `{"title":"Crash on login","labels":["auth"]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/boards/board-1/cards?template=bug", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d. body: %s", w.Code, http.StatusCreated, w.Body.String())
+	}
+	var card domain.Card
+	if err := json.NewDecoder(w.Body).Decode(&card); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if card.List != "todo" || card.Description != "## Steps to reproduce" {
+		t.Errorf("template not applied: %+v", card)
+	}
+	if len(card.Labels) != 2 || card.Labels[0] != "bug" || card.Labels[1] != "auth" {
+		t.Errorf("labels = %v, want [bug auth]", card.Labels)
+	}
+	if len(card.Todos) != 1 || card.Todos[0].ID == "" {
+		t.Errorf("todos = %+v", card.Todos)
+	}
+}
+
+func TestCardHandler_Create_UnknownTemplate(t *testing.T) {
+	cardRepo, boardRepo, templateRepo := newTemplateFixture()
+	r := newCardRouterWithTemplates(cardRepo, boardRepo, templateRepo)
+
+	body := `{"title":"Crash on login","list":"todo"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/boards/board-1/cards?template=missing", bytes.NewBufferString(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestCardHandler_Create_TemplatePath(t *testing.T) {
+	cardRepo, boardRepo, templateRepo := newTemplateFixture()
+	// A repository that resolved the ID as a path would find this one.
+	templateRepo.templates = append(templateRepo.templates, domain.CardTemplate{ID: "../x", Name: "Outside", List: "todo"})
+	r := newCardRouterWithTemplates(cardRepo, boardRepo, templateRepo)
+
+	for _, id := range []string{"../x", "..%2Fx"} {
+		body := `{"title":"Crash on login"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/boards/board-1/cards?template="+id, bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("template %s: status = %d, want %d", id, w.Code, http.StatusBadRequest)
+		}
+	}
+	if cardRepo.card != nil {
+		t.Errorf("card created: %+v", cardRepo.card)
+	}
+}
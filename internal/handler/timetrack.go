@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type timerRequest struct {
+	User string `json:"user"`
+	Note string `json:"note"`
+}
+
+func (h *CardHandler) startTimer(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+
+	var req timerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	card, err := h.uc.StartTimer(r.Context(), boardID, cardID, req.User, req.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, card)
+}
+
+func (h *CardHandler) stopTimer(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+
+	var req timerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	card, err := h.uc.StopTimer(r.Context(), boardID, cardID, req.User)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, card)
+}
+
+type logTimeRequest struct {
+	User    string    `json:"user"`
+	Minutes int       `json:"minutes"`
+	Start   time.Time `json:"start"`
+	Note    string    `json:"note"`
+}
+
+func (h *CardHandler) logTime(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+
+	var req logTimeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	card, err := h.uc.LogTime(r.Context(), boardID, cardID, usecase.TimeLog{
+		User:    req.User,
+		Minutes: req.Minutes,
+		Start:   req.Start,
+		Note:    req.Note,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusCreated, card)
+}
+
+func (h *CardHandler) deleteTimeEntry(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+	entryID := chi.URLParam(r, "entryId")
+
+	card, err := h.uc.DeleteTimeEntry(r.Context(), boardID, cardID, entryID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, card)
+}
+
+func (h *CardHandler) timeReport(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+
+	report, err := h.uc.TimeReport(r.Context(), boardID, time.Now())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, report)
+}
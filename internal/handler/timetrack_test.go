@@ -0,0 +1,83 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func TestCardHandler_Timer(t *testing.T) {
+	board := domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}
+	boardRepo := &mockBoardRepo{boards: []domain.Board{board}, board: &board}
+	cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1", Title: "Test", List: "todo"}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := post("/api/boards/board-1/cards/card-1/timer/start", `{"user":"alice"}`); w.Code != http.StatusOK {
+		t.Fatalf("start status = %d, want %d. body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	if w := post("/api/boards/board-1/cards/card-1/timer/start", `{"user":"alice"}`); w.Code != http.StatusConflict {
+		t.Errorf("second start status = %d, want %d", w.Code, http.StatusConflict)
+	}
+
+	w := post("/api/boards/board-1/cards/card-1/timer/stop", `{"user":"alice"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("stop status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var card domain.Card
+	if err := json.NewDecoder(w.Body).Decode(&card); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(card.TimeEntries) != 1 || card.TimeEntries[0].Running() {
+		t.Errorf("TimeEntries = %+v", card.TimeEntries)
+	}
+
+	if w := post("/api/boards/board-1/cards/card-1/timer/stop", `{"user":"alice"}`); w.Code != http.StatusNotFound {
+		t.Errorf("second stop status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestCardHandler_LogTime_Invalid(t *testing.T) {
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1"}}
+	cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1", Title: "Test", List: "todo"}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/boards/board-1/cards/card-1/time-entries", bytes.NewBufferString(`{"user":"alice","minutes":0}`))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestCardHandler_TimeReport(t *testing.T) {
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1"}}
+	cardRepo := &mockCardRepo{cards: []domain.Card{{ID: "card-1", Title: "Test", List: "todo", EstimateMinutes: 60}}}
+	r := newCardRouter(cardRepo, boardRepo)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/boards/board-1/time-report", http.NoBody)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var report domain.TimeReport
+	if err := json.NewDecoder(w.Body).Decode(&report); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if report.EstimateMinutes != 60 || len(report.ByCard) != 1 {
+		t.Errorf("report = %+v", report)
+	}
+}
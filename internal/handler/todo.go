@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type addTodoRequest struct {
+	Text string `json:"text"`
+}
+
+func (h *CardHandler) addTodo(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+
+	var req addTodoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	card, err := h.uc.AddTodo(r.Context(), boardID, cardID, req.Text)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusCreated, card)
+}
+
+type updateTodoRequest struct {
+	Text      *string `json:"text"`
+	Completed *bool   `json:"completed"`
+}
+
+func (h *CardHandler) updateTodo(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+	todoID := chi.URLParam(r, "todoId")
+
+	var req updateTodoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	card, err := h.uc.UpdateTodo(r.Context(), boardID, cardID, todoID, usecase.TodoUpdate{
+		Text:      req.Text,
+		Completed: req.Completed,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, card)
+}
+
+func (h *CardHandler) toggleTodo(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+	todoID := chi.URLParam(r, "todoId")
+
+	card, err := h.uc.ToggleTodo(r.Context(), boardID, cardID, todoID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, card)
+}
+
+type moveTodoRequest struct {
+	Position int `json:"position"`
+}
+
+func (h *CardHandler) moveTodo(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+	todoID := chi.URLParam(r, "todoId")
+
+	var req moveTodoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+
+	card, err := h.uc.MoveTodo(r.Context(), boardID, cardID, todoID, req.Position)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, card)
+}
+
+func (h *CardHandler) deleteTodo(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+	todoID := chi.URLParam(r, "todoId")
+
+	card, err := h.uc.DeleteTodo(r.Context(), boardID, cardID, todoID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, card)
+}
+
+type convertTodoRequest struct {
+	List string `json:"list"`
+}
+
+func (h *CardHandler) convertTodo(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	cardID := chi.URLParam(r, "cardId")
+	todoID := chi.URLParam(r, "todoId")
+
+	var req convertTodoRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeBadRequest(w, "invalid request body")
+			return
+		}
+	}
+
+	created, err := h.uc.ConvertTodo(r.Context(), boardID, cardID, todoID, req.List)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusCreated, created)
+}
@@ -0,0 +1,53 @@
+package handler_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func TestCardHandler_Todos(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{"add", http.MethodPost, "/api/boards/board-1/cards/card-1/todos", `{"text":"new"}`, http.StatusCreated},
+		{"add empty", http.MethodPost, "/api/boards/board-1/cards/card-1/todos", `{"text":""}`, http.StatusBadRequest},
+		{"add invalid body", http.MethodPost, "/api/boards/board-1/cards/card-1/todos", `invalid`, http.StatusBadRequest},
+		{"update", http.MethodPatch, "/api/boards/board-1/cards/card-1/todos/t1", `{"completed":true}`, http.StatusOK},
+		{"update missing", http.MethodPatch, "/api/boards/board-1/cards/card-1/todos/missing", `{"completed":true}`, http.StatusNotFound},
+		{"toggle", http.MethodPost, "/api/boards/board-1/cards/card-1/todos/t1/toggle", ``, http.StatusOK},
+		{"move", http.MethodPatch, "/api/boards/board-1/cards/card-1/todos/t2/move", `{"position":0}`, http.StatusOK},
+		{"delete", http.MethodDelete, "/api/boards/board-1/cards/card-1/todos/t1", ``, http.StatusOK},
+		{"convert", http.MethodPost, "/api/boards/board-1/cards/card-1/todos/t1/convert", ``, http.StatusCreated},
+		{"convert invalid list", http.MethodPost, "/api/boards/board-1/cards/card-1/todos/t1/convert", `{"list":"nope"}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}}
+			cardRepo := &mockCardRepo{
+				nextID: "20260124-002",
+				cards: []domain.Card{{ID: "card-1", Title: "Test", List: "todo", Todos: []domain.TodoItem{
+					{ID: "t1", Text: "one"},
+					{ID: "t2", Text: "two"},
+				}}},
+			}
+			r := newCardRouter(cardRepo, boardRepo)
+
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d. body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type TrashHandler struct {
+	uc *usecase.TrashUseCase
+}
+
+func NewTrashHandler(uc *usecase.TrashUseCase) *TrashHandler {
+	return &TrashHandler{uc: uc}
+}
+
+func (h *TrashHandler) Register(r chi.Router) {
+	r.Get("/api/trash", h.list)
+	r.Post("/api/trash/{itemId}/restore", h.restore)
+	r.Delete("/api/trash/{itemId}", h.purge)
+}
+
+func (h *TrashHandler) list(w http.ResponseWriter, r *http.Request) {
+	items, err := h.uc.List(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, items)
+}
+
+func (h *TrashHandler) restore(w http.ResponseWriter, r *http.Request) {
+	item, err := h.uc.Restore(r.Context(), chi.URLParam(r, "itemId"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, item)
+}
+
+func (h *TrashHandler) purge(w http.ResponseWriter, r *http.Request) {
+	if err := h.uc.Purge(r.Context(), chi.URLParam(r, "itemId")); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,107 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/handler"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type mockTrashRepo struct {
+	items []domain.TrashItem
+}
+
+func (m *mockTrashRepo) List(_ context.Context) ([]domain.TrashItem, error) {
+	return m.items, nil
+}
+
+func (m *mockTrashRepo) Restore(_ context.Context, itemID string) (*domain.TrashItem, error) {
+	for i := range m.items {
+		if m.items[i].ID == itemID {
+			return &m.items[i], nil
+		}
+	}
+	return nil, &domain.ErrNotFound{Resource: "trash item", ID: itemID}
+}
+
+func (m *mockTrashRepo) Purge(_ context.Context, itemID string) error {
+	for i := range m.items {
+		if m.items[i].ID == itemID {
+			m.items = append(m.items[:i], m.items[i+1:]...)
+			return nil
+		}
+	}
+	return &domain.ErrNotFound{Resource: "trash item", ID: itemID}
+}
+
+func TestTrashHandler(t *testing.T) {
+	repo := &mockTrashRepo{items: []domain.TrashItem{
+		{ID: "20260124-103000-0a1b2c3d", Kind: domain.TrashBoard, BoardID: "board-1", Name: "Board", DeletedAt: time.Now()},
+	}}
+	r := chi.NewRouter()
+	handler.NewTrashHandler(usecase.NewTrashUseCase(repo, time.Hour)).Register(r)
+
+	do := func(method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, http.NoBody)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do(http.MethodGet, "/api/trash")
+	if w.Code != http.StatusOK {
+		t.Fatalf("list status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var items []domain.TrashItem
+	if err := json.NewDecoder(w.Body).Decode(&items); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(items) != 1 || items[0].ExpiresAt.IsZero() {
+		t.Errorf("items = %+v", items)
+	}
+
+	if w := do(http.MethodPost, "/api/trash/20260124-103000-0a1b2c3d/restore"); w.Code != http.StatusOK {
+		t.Errorf("restore status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if w := do(http.MethodPost, "/api/trash/20260124-103000-ffffffff/restore"); w.Code != http.StatusNotFound {
+		t.Errorf("restore missing status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+	if w := do(http.MethodDelete, "/api/trash/20260124-103000-0a1b2c3d"); w.Code != http.StatusNoContent {
+		t.Errorf("purge status = %d, want %d", w.Code, http.StatusNoContent)
+	}
+	if w := do(http.MethodDelete, "/api/trash/20260124-103000-0a1b2c3d"); w.Code != http.StatusNotFound {
+		t.Errorf("purge again status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestTrashHandler_RejectsPaths(t *testing.T) {
+	repo := &mockTrashRepo{items: []domain.TrashItem{
+		{ID: "20260124-103000-0a1b2c3d", Kind: domain.TrashBoard, BoardID: "board-1", DeletedAt: time.Now()},
+	}}
+	r := chi.NewRouter()
+	handler.NewTrashHandler(usecase.NewTrashUseCase(repo, time.Hour)).Register(r)
+
+	for _, id := range []string{"..", "%2E%2E", "..%2Fboards", "20260124-103000-0a1b2c3d%2F.."} {
+		for _, req := range []*http.Request{
+			httptest.NewRequest(http.MethodDelete, "/api/trash/"+id, http.NoBody),
+			httptest.NewRequest(http.MethodPost, "/api/trash/"+id+"/restore", http.NoBody),
+		} {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("%s %s status = %d, want %d", req.Method, req.URL.Path, w.Code, http.StatusBadRequest)
+			}
+		}
+	}
+	if len(repo.items) != 1 {
+		t.Errorf("items = %+v, want the item untouched", repo.items)
+	}
+}
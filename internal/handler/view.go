@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type ViewHandler struct {
+	uc *usecase.ViewUseCase
+}
+
+func NewViewHandler(uc *usecase.ViewUseCase) *ViewHandler {
+	return &ViewHandler{uc: uc}
+}
+
+func (h *ViewHandler) Register(r chi.Router) {
+	r.Get("/api/boards/{id}/views", h.list)
+	r.Get("/api/boards/{id}/views/{viewId}", h.get)
+	r.Put("/api/boards/{id}/views/{viewId}", h.save)
+	r.Delete("/api/boards/{id}/views/{viewId}", h.delete)
+	r.Get("/api/boards/{id}/views/{viewId}/cards", h.cards)
+}
+
+func (h *ViewHandler) list(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+
+	views, err := h.uc.List(r.Context(), boardID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, views)
+}
+
+func (h *ViewHandler) get(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	viewID := chi.URLParam(r, "viewId")
+
+	view, err := h.uc.Get(r.Context(), boardID, viewID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, view)
+}
+
+func (h *ViewHandler) save(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+
+	var view domain.View
+	if err := json.NewDecoder(r.Body).Decode(&view); err != nil {
+		writeBadRequest(w, "invalid request body")
+		return
+	}
+	view.ID = chi.URLParam(r, "viewId")
+
+	saved, err := h.uc.Save(r.Context(), boardID, &view)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	respondJSON(w, http.StatusOK, saved)
+}
+
+func (h *ViewHandler) delete(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	viewID := chi.URLParam(r, "viewId")
+
+	if err := h.uc.Delete(r.Context(), boardID, viewID); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ViewHandler) cards(w http.ResponseWriter, r *http.Request) {
+	boardID := chi.URLParam(r, "id")
+	viewID := chi.URLParam(r, "viewId")
+	query := r.URL.Query()
+
+	limit := 0
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeBadRequest(w, "limit must be a positive integer")
+			return
+		}
+		limit = n
+	}
+
+	page, err := h.uc.Cards(r.Context(), boardID, viewID, query.Get("cursor"), limit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if page.NextCursor != "" {
+		w.Header().Set("X-Next-Cursor", page.NextCursor)
+	}
+	respondJSON(w, http.StatusOK, page.Cards)
+}
@@ -0,0 +1,84 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/handler"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type mockViewRepo struct {
+	views []domain.View
+}
+
+func (m *mockViewRepo) ListByBoard(_ context.Context, _ string) ([]domain.View, error) {
+	return m.views, nil
+}
+
+func (m *mockViewRepo) Save(_ context.Context, _ string, view *domain.View) error {
+	m.views = append(m.views, *view)
+	return nil
+}
+
+func (m *mockViewRepo) Delete(_ context.Context, _, viewID string) error {
+	for i := range m.views {
+		if m.views[i].ID == viewID {
+			m.views = append(m.views[:i], m.views[i+1:]...)
+			return nil
+		}
+	}
+	return &domain.ErrNotFound{Resource: "view", ID: viewID}
+}
+
+func TestViewHandler(t *testing.T) {
+	board := domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}
+	boardRepo := &mockBoardRepo{board: &board}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "Crash", List: "todo", Labels: []string{"bug"}},
+		{ID: "card-2", Title: "Export", List: "todo"},
+	}}
+	uc := usecase.NewViewUseCase(&mockViewRepo{}, boardRepo, usecase.NewCardUseCase(cardRepo, boardRepo))
+	r := chi.NewRouter()
+	handler.NewViewHandler(uc).Register(r)
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := do(http.MethodPut, "/api/boards/board-1/views/bugs", `{"name":"Bugs","filter":{"labels":["bug"]}}`); w.Code != http.StatusOK {
+		t.Fatalf("save status = %d, want %d. body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+	if w := do(http.MethodPut, "/api/boards/board-1/views/bad", `{"name":"Bad","sort":"priority"}`); w.Code != http.StatusBadRequest {
+		t.Errorf("invalid save status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	w := do(http.MethodGet, "/api/boards/board-1/views/bugs/cards", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("cards status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var cards []domain.Card
+	if err := json.NewDecoder(w.Body).Decode(&cards); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(cards) != 1 || cards[0].ID != "card-1" {
+		t.Errorf("cards = %+v", cards)
+	}
+
+	if w := do(http.MethodDelete, "/api/boards/board-1/views/bugs", ""); w.Code != http.StatusNoContent {
+		t.Errorf("delete status = %d, want %d", w.Code, http.StatusNoContent)
+	}
+	if w := do(http.MethodGet, "/api/boards/board-1/views/bugs", ""); w.Code != http.StatusNotFound {
+		t.Errorf("get after delete status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
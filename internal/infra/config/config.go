@@ -0,0 +1,125 @@
+// Package config reads the optional config.yaml in the .tasks directory.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the config file inside the base path.
+const FileName = "config.yaml"
+
+// Driver names where boards and cards are stored.
+type Driver string
+
+const (
+	// DriverYAML keeps one YAML file per board and card under .tasks.
+	DriverYAML Driver = "yaml"
+	// DriverSQLite keeps boards and cards in a SQLite database.
+	DriverSQLite Driver = "sqlite"
+)
+
+// defaultSQLitePath is the database file used when none is configured.
+const defaultSQLitePath = "tasks.db"
+
+type Config struct {
+	// SchemaVersion is the version of the data layout under the base path.
+	// 0 means data written before versioning was introduced.
+	SchemaVersion int     `yaml:"schema_version,omitempty"`
+	Storage       Storage `yaml:"storage"`
+}
+
+type Storage struct {
+	Driver Driver `yaml:"driver"`
+	// Path is the SQLite database file, relative to the base path unless absolute.
+	Path string `yaml:"path,omitempty"`
+}
+
+// Load reads basePath/config.yaml. A missing file gives the defaults: YAML
+// storage and, for SQLite, tasks.db in the base path.
+func Load(basePath string) (*Config, error) {
+	cfg := &Config{}
+	data, err := os.ReadFile(filepath.Join(basePath, FileName))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	if err == nil {
+		if err := yamlv3.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("parse config: %w", err)
+		}
+	}
+
+	if cfg.Storage.Driver == "" {
+		cfg.Storage.Driver = DriverYAML
+	}
+	if err := cfg.Storage.Driver.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Storage.Path == "" {
+		cfg.Storage.Path = defaultSQLitePath
+	}
+	if !filepath.IsAbs(cfg.Storage.Path) {
+		cfg.Storage.Path = filepath.Join(basePath, cfg.Storage.Path)
+	}
+	return cfg, nil
+}
+
+// SetSchemaVersion records version in basePath/config.yaml, creating the file
+// if needed and keeping the rest of it, comments included, as it is.
+func SetSchemaVersion(basePath string, version int) error {
+	path := filepath.Join(basePath, FileName)
+	var doc yamlv3.Node
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("read config: %w", err)
+	}
+	if err := yamlv3.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parse config: %w", err)
+	}
+	if doc.Kind == 0 {
+		doc = yamlv3.Node{Kind: yamlv3.DocumentNode, Content: []*yamlv3.Node{{Kind: yamlv3.MappingNode, Tag: "!!map"}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yamlv3.MappingNode {
+		return fmt.Errorf("parse config: %s is not a mapping", FileName)
+	}
+
+	value := &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!int", Value: strconv.Itoa(version)}
+	found := false
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "schema_version" {
+			root.Content[i+1] = value
+			found = true
+			break
+		}
+	}
+	if !found {
+		key := &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: "schema_version"}
+		root.Content = append([]*yamlv3.Node{key, value}, root.Content...)
+	}
+
+	out, err := yamlv3.Marshal(&doc)
+	if err != nil {
+		return fmt.Errorf("marshal config: %w", err)
+	}
+	if err := os.MkdirAll(basePath, 0o755); err != nil {
+		return fmt.Errorf("create base dir: %w", err)
+	}
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+	return nil
+}
+
+// Validate reports an unknown driver.
+func (d Driver) Validate() error {
+	switch d {
+	case DriverYAML, DriverSQLite:
+		return nil
+	}
+	return fmt.Errorf("unknown storage driver %q", d)
+}
@@ -0,0 +1,95 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/config"
+)
+
+func TestLoad_Defaults(t *testing.T) {
+	base := t.TempDir()
+
+	cfg, err := config.Load(base)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Storage.Driver != config.DriverYAML {
+		t.Errorf("driver = %q, want yaml", cfg.Storage.Driver)
+	}
+	if want := filepath.Join(base, "tasks.db"); cfg.Storage.Path != want {
+		t.Errorf("path = %q, want %q", cfg.Storage.Path, want)
+	}
+}
+
+func TestLoad_SQLite(t *testing.T) {
+	base := t.TempDir()
+	data := "storage:\n  driver: sqlite\n  path: data/board.db\n"
+	if err := os.WriteFile(filepath.Join(base, config.FileName), []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := config.Load(base)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Storage.Driver != config.DriverSQLite {
+		t.Errorf("driver = %q, want sqlite", cfg.Storage.Driver)
+	}
+	if want := filepath.Join(base, "data", "board.db"); cfg.Storage.Path != want {
+		t.Errorf("path = %q, want %q", cfg.Storage.Path, want)
+	}
+}
+
+func TestLoad_UnknownDriver(t *testing.T) {
+	base := t.TempDir()
+	if err := os.WriteFile(filepath.Join(base, config.FileName), []byte("storage:\n  driver: postgres\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := config.Load(base); err == nil {
+		t.Error("expected an error for an unknown driver")
+	}
+}
+
+func TestSetSchemaVersion(t *testing.T) {
+	base := t.TempDir()
+	data := "# comment kept\ndefault_board: alpha\nstorage:\n  driver: sqlite\n"
+	if err := os.WriteFile(filepath.Join(base, config.FileName), []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []int{1, 2} {
+		if err := config.SetSchemaVersion(base, v); err != nil {
+			t.Fatalf("SetSchemaVersion(%d): %v", v, err)
+		}
+		cfg, err := config.Load(base)
+		if err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		if cfg.SchemaVersion != v || cfg.Storage.Driver != config.DriverSQLite {
+			t.Errorf("config = %+v, want schema version %d and sqlite", cfg, v)
+		}
+	}
+	got, err := os.ReadFile(filepath.Join(base, config.FileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(got), "# comment kept") || !strings.Contains(string(got), "default_board: alpha") {
+		t.Errorf("config.yaml lost content:\n%s", got)
+	}
+}
+
+func TestSetSchemaVersion_NoFile(t *testing.T) {
+	base := filepath.Join(t.TempDir(), ".tasks")
+
+	if err := config.SetSchemaVersion(base, 1); err != nil {
+		t.Fatalf("SetSchemaVersion: %v", err)
+	}
+	cfg, err := config.Load(base)
+	if err != nil || cfg.SchemaVersion != 1 {
+		t.Errorf("Load = %+v, %v", cfg, err)
+	}
+}
@@ -0,0 +1,233 @@
+// Package memory keeps boards and cards in memory. It is meant for tests and
+// for trying things out; nothing survives the process.
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// Store holds copies of the boards and cards saved to it, so callers never
+// share data with it.
+type Store struct {
+	mu     sync.RWMutex
+	boards map[string]*domain.Board
+	cards  map[string]map[string]*domain.Card
+	// idCounters are the last numbers issued on prefix boards.
+	idCounters map[string]int
+}
+
+func NewStore() *Store {
+	return &Store{
+		boards:     make(map[string]*domain.Board),
+		cards:      make(map[string]map[string]*domain.Card),
+		idCounters: make(map[string]int),
+	}
+}
+
+// BoardRepository implementation
+
+func (s *Store) List(_ context.Context) ([]domain.Board, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	boards := make([]domain.Board, 0, len(s.boards))
+	for _, b := range s.boards {
+		boards = append(boards, *cloneBoard(b))
+	}
+	sort.Slice(boards, func(i, j int) bool { return boards[i].ID < boards[j].ID })
+	return boards, nil
+}
+
+func (s *Store) Get(_ context.Context, id string) (*domain.Board, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	b, ok := s.boards[id]
+	if !ok {
+		return nil, &domain.ErrNotFound{Resource: "board", ID: id}
+	}
+	return cloneBoard(b), nil
+}
+
+func (s *Store) Save(_ context.Context, board *domain.Board) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.boards[board.ID] = cloneBoard(board)
+	return nil
+}
+
+// Delete removes the board and its cards. There is no trash in memory.
+func (s *Store) Delete(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.boards[id]; !ok {
+		return &domain.ErrNotFound{Resource: "board", ID: id}
+	}
+	delete(s.boards, id)
+	delete(s.cards, id)
+	return nil
+}
+
+// CardRepository implementation
+
+func (s *Store) ListByBoard(_ context.Context, boardID string, includeArchived bool) ([]domain.Card, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cards := []domain.Card{}
+	for _, c := range s.cards[boardID] {
+		if includeArchived || !c.Archived {
+			cards = append(cards, *c.Clone())
+		}
+	}
+	domain.SortByRank(cards)
+	return cards, nil
+}
+
+func (s *Store) GetCard(_ context.Context, boardID, cardID string) (*domain.Card, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	c, ok := s.cards[boardID][cardID]
+	if !ok {
+		return nil, &domain.ErrNotFound{Resource: "card", ID: cardID}
+	}
+	return c.Clone(), nil
+}
+
+func (s *Store) SaveCard(_ context.Context, boardID string, card *domain.Card) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.putCardLocked(boardID, card)
+	return nil
+}
+
+// SaveCards saves and deletes all the cards under one lock. A card to delete
+// must exist, or be saved earlier in writes; this is checked first, so that
+// nothing is written if it fails.
+func (s *Store) SaveCards(_ context.Context, writes []domain.CardWrite) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exists := make(map[domain.CardRef]bool)
+	for _, w := range writes {
+		ref := domain.CardRef{Board: w.BoardID, ID: w.Card.ID}
+		found, seen := exists[ref]
+		if !seen {
+			_, found = s.cards[w.BoardID][w.Card.ID]
+		}
+		if w.Delete && !found {
+			return &domain.ErrNotFound{Resource: "card", ID: w.Card.ID}
+		}
+		exists[ref] = !w.Delete
+	}
+
+	for _, w := range writes {
+		if w.Delete {
+			delete(s.cards[w.BoardID], w.Card.ID)
+		} else {
+			s.putCardLocked(w.BoardID, w.Card)
+		}
+	}
+	return nil
+}
+
+// DeleteCard removes the card. There is no trash in memory.
+func (s *Store) DeleteCard(_ context.Context, boardID, cardID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.cards[boardID][cardID]; !ok {
+		return &domain.ErrNotFound{Resource: "card", ID: cardID}
+	}
+	delete(s.cards[boardID], cardID)
+	return nil
+}
+
+func (s *Store) NextID(_ context.Context, boardID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.nextIDLocked(boardID), nil
+}
+
+func (s *Store) CreateCard(_ context.Context, boardID string, card *domain.Card) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	card.ID = s.nextIDLocked(boardID)
+	s.putCardLocked(boardID, card)
+	return card.ID, nil
+}
+
+func (s *Store) TransferCard(_ context.Context, fromBoardID, toBoardID string, card *domain.Card) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	oldID := card.ID
+	if _, ok := s.cards[fromBoardID][oldID]; !ok {
+		return "", &domain.ErrNotFound{Resource: "card", ID: oldID}
+	}
+	if _, ok := s.cards[toBoardID][oldID]; ok {
+		card.ID = s.nextIDLocked(toBoardID)
+	}
+	delete(s.cards[fromBoardID], oldID)
+	s.putCardLocked(toBoardID, card)
+	return card.ID, nil
+}
+
+func (s *Store) putCardLocked(boardID string, card *domain.Card) {
+	if s.cards[boardID] == nil {
+		s.cards[boardID] = make(map[string]*domain.Card)
+	}
+	c := card.Clone()
+	c.Order = 0
+	s.cards[boardID][card.ID] = c
+}
+
+// nextIDLocked returns a new card ID in the board's ID format.
+func (s *Store) nextIDLocked(boardID string) string {
+	taken := make([]string, 0, len(s.cards[boardID]))
+	for id := range s.cards[boardID] {
+		taken = append(taken, id)
+	}
+	id, counter := domain.NextCardID(s.boards[boardID], taken, s.idCounters[boardID], time.Now())
+	s.idCounters[boardID] = counter
+	return id
+}
+
+// IDCounterRepository implementation
+
+func (s *Store) IDCounter(_ context.Context, boardID string) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.idCounters[boardID], nil
+}
+
+func (s *Store) SetIDCounter(_ context.Context, boardID string, counter int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.boards[boardID]; !ok {
+		return &domain.ErrNotFound{Resource: "board", ID: boardID}
+	}
+	s.idCounters[boardID] = counter
+	return nil
+}
+
+func cloneBoard(b *domain.Board) *domain.Board {
+	cp := *b
+	cp.Lists = slices.Clone(b.Lists)
+	cp.Fields = slices.Clone(b.Fields)
+	return &cp
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/memory"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/repotest"
+)
+
+func TestStore_Contract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repositories {
+		store := memory.NewStore()
+		return repotest.Repositories{Boards: store, Cards: memory.NewCardRepositoryAdapter(store)}
+	})
+}
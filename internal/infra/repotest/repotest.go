@@ -0,0 +1,658 @@
+// Package repotest checks that an implementation of domain.BoardRepository
+// and domain.CardRepository behaves as the usecases expect. Every
+// implementation runs it from its own tests:
+//
+//	func TestContract(t *testing.T) {
+//		repotest.Run(t, func(t *testing.T) repotest.Repositories {
+//			store := NewStore(t.TempDir())
+//			return repotest.Repositories{Boards: store, Cards: NewCardRepositoryAdapter(store)}
+//		})
+//	}
+package repotest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// Repositories are the repositories under test. They must share one storage:
+// deleting a board deletes its cards.
+type Repositories struct {
+	Boards domain.BoardRepository
+	Cards  domain.CardRepository
+	// Comments, Attachments and Trash are optional. With them, the cases on
+	// the comments and attachments that go with a moved or deleted card run.
+	Comments    domain.CommentRepository
+	Attachments domain.AttachmentRepository
+	Trash       domain.TrashRepository
+}
+
+// Run runs the contract, giving every case fresh, empty repositories from
+// newRepos.
+func Run(t *testing.T, newRepos func(t *testing.T) Repositories) {
+	cases := []struct {
+		name string
+		fn   func(t *testing.T, r Repositories)
+	}{
+		{"Board/RoundTrip", testBoardRoundTrip},
+		{"Board/ListSortedByID", testBoardListSorted},
+		{"Board/NotFound", testBoardNotFound},
+		{"Board/DeleteRemovesCards", testBoardDeleteRemovesCards},
+		{"Card/RoundTrip", testCardRoundTrip},
+		{"Card/ReturnsCopies", testCardReturnsCopies},
+		{"Card/ListOrder", testCardListOrder},
+		{"Card/ArchivedFilter", testCardArchivedFilter},
+		{"Card/BoardsAreSeparate", testCardBoardsAreSeparate},
+		{"Card/NotFound", testCardNotFound},
+		{"Card/Delete", testCardDelete},
+		{"Card/CreateAssignsIDs", testCardCreate},
+		{"Card/PrefixIDs", testCardPrefixIDs},
+		{"Card/ConcurrentNextID", testCardConcurrentNextID},
+		{"Card/ULIDs", testCardULIDs},
+		{"Card/SaveAll", testCardSaveAll},
+		{"Card/SaveAllDeletes", testCardSaveAllDeletes},
+		{"Card/Transfer", testCardTransfer},
+		{"Card/TransferMovesFiles", testCardTransferMovesFiles},
+		{"Card/DeleteTrashesFiles", testCardDeleteTrashesFiles},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.fn(t, newRepos(t))
+		})
+	}
+}
+
+func newBoard(id string) *domain.Board {
+	return &domain.Board{
+		ID:   id,
+		Name: "Board " + id,
+		Lists: []domain.List{
+			{ID: "todo", Name: "Todo"},
+			{ID: "done", Name: "Done", Done: true},
+		},
+	}
+}
+
+func saveBoard(t *testing.T, r Repositories, id string) {
+	t.Helper()
+	if err := r.Boards.Save(context.Background(), newBoard(id)); err != nil {
+		t.Fatalf("Save board %s: %v", id, err)
+	}
+}
+
+func saveCard(t *testing.T, r Repositories, boardID string, card *domain.Card) {
+	t.Helper()
+	if err := r.Cards.Save(context.Background(), boardID, card); err != nil {
+		t.Fatalf("Save card %s: %v", card.ID, err)
+	}
+}
+
+func ids(cards []domain.Card) []string {
+	out := make([]string, len(cards))
+	for i, c := range cards {
+		out[i] = c.ID
+	}
+	return out
+}
+
+func wantNotFound(t *testing.T, what string, err error) {
+	t.Helper()
+	var notFound *domain.ErrNotFound
+	if !errors.As(err, &notFound) {
+		t.Errorf("%s: expected *domain.ErrNotFound, got %v", what, err)
+	}
+}
+
+func testBoardRoundTrip(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	board := newBoard("board-1")
+	board.Archived = true
+	board.Fields = []domain.FieldDef{{ID: "owner", Name: "Owner", Type: domain.FieldText}}
+	if err := r.Boards.Save(ctx, board); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	got, err := r.Boards.Get(ctx, "board-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Name != board.Name || !slices.Equal(got.Lists, board.Lists) || !got.Archived ||
+		len(got.Fields) != 1 || got.Fields[0].ID != "owner" {
+		t.Errorf("Get = %+v, want %+v", got, board)
+	}
+
+	board.Name = "Renamed"
+	if err := r.Boards.Save(ctx, board); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if got, _ := r.Boards.Get(ctx, "board-1"); got.Name != "Renamed" {
+		t.Errorf("Name after second save = %q, want Renamed", got.Name)
+	}
+}
+
+func testBoardListSorted(t *testing.T, r Repositories) {
+	boards, err := r.Boards.List(context.Background())
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if boards == nil || len(boards) != 0 {
+		t.Errorf("List of no boards = %#v, want an empty slice", boards)
+	}
+
+	for _, id := range []string{"c", "a", "b"} {
+		saveBoard(t, r, id)
+	}
+	boards, err = r.Boards.List(context.Background())
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	var got []string
+	for _, b := range boards {
+		got = append(got, b.ID)
+	}
+	if want := []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("List = %v, want %v", got, want)
+	}
+}
+
+func testBoardNotFound(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	_, err := r.Boards.Get(ctx, "missing")
+	wantNotFound(t, "Get", err)
+	wantNotFound(t, "Delete", r.Boards.Delete(ctx, "missing"))
+}
+
+func testBoardDeleteRemovesCards(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	saveBoard(t, r, "board-1")
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-1", Title: "A", List: "todo", Rank: "i"})
+
+	if err := r.Boards.Delete(ctx, "board-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	_, err := r.Boards.Get(ctx, "board-1")
+	wantNotFound(t, "Get deleted board", err)
+	_, err = r.Cards.Get(ctx, "board-1", "card-1")
+	wantNotFound(t, "Get card of deleted board", err)
+	if boards, _ := r.Boards.List(ctx); len(boards) != 0 {
+		t.Errorf("List after delete = %v", boards)
+	}
+}
+
+func testCardRoundTrip(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	created := time.Date(2026, 1, 24, 10, 30, 0, 0, time.UTC)
+	card := &domain.Card{
+		ID:          "card-1",
+		Title:       "Title",
+		List:        "todo",
+		Rank:        "i",
+		Description: "Body",
+		Labels:      []string{"bug", "ui"},
+		Todos:       []domain.TodoItem{{ID: "t1", Text: "step", Completed: true}},
+		BlockedBy:   []domain.CardRef{{Board: "board-2", ID: "card-9"}},
+		Parent:      "card-0",
+		Fields:      map[string]any{"owner": "alice"},
+		Archived:    true,
+		CreatedAt:   created,
+		UpdatedAt:   created.Add(time.Hour),
+	}
+	saveCard(t, r, "board-1", card)
+
+	got, err := r.Cards.Get(ctx, "board-1", "card-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.ID != card.ID || got.Title != card.Title || got.List != card.List || got.Rank != card.Rank ||
+		got.Description != card.Description || got.Parent != card.Parent || got.Archived != card.Archived {
+		t.Errorf("Get = %+v, want %+v", got, card)
+	}
+	if !slices.Equal(got.Labels, card.Labels) || !slices.Equal(got.Todos, card.Todos) || !slices.Equal(got.BlockedBy, card.BlockedBy) {
+		t.Errorf("slices = %v %v %v, want %v %v %v", got.Labels, got.Todos, got.BlockedBy, card.Labels, card.Todos, card.BlockedBy)
+	}
+	if got.Fields["owner"] != "alice" {
+		t.Errorf("Fields = %v", got.Fields)
+	}
+	if !got.CreatedAt.Equal(card.CreatedAt) || !got.UpdatedAt.Equal(card.UpdatedAt) {
+		t.Errorf("times = %v %v, want %v %v", got.CreatedAt, got.UpdatedAt, card.CreatedAt, card.UpdatedAt)
+	}
+}
+
+func testCardReturnsCopies(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	card := &domain.Card{ID: "card-1", Title: "A", List: "todo", Rank: "i", Labels: []string{"bug"}}
+	saveCard(t, r, "board-1", card)
+
+	// Neither the saved card nor a returned one is shared with the store.
+	card.Labels[0] = "changed-after-save"
+	got, _ := r.Cards.Get(ctx, "board-1", "card-1")
+	got.Labels[0] = "changed-after-get"
+	listed, _ := r.Cards.ListByBoard(ctx, "board-1", false)
+	listed[0].Labels[0] = "changed-after-list"
+
+	if again, _ := r.Cards.Get(ctx, "board-1", "card-1"); again.Labels[0] != "bug" {
+		t.Errorf("Labels = %v, want [bug]", again.Labels)
+	}
+}
+
+func testCardListOrder(t *testing.T, r Repositories) {
+	for _, c := range []domain.Card{
+		{ID: "card-1", Title: "A", List: "todo", Rank: "r"},
+		{ID: "card-2", Title: "B", List: "done", Rank: "a"},
+		{ID: "card-3", Title: "C", List: "todo", Rank: "c"},
+		{ID: "card-4", Title: "D", List: "todo", Rank: "c"},
+	} {
+		saveCard(t, r, "board-1", &c)
+	}
+
+	cards, err := r.Cards.ListByBoard(context.Background(), "board-1", false)
+	if err != nil {
+		t.Fatalf("ListByBoard: %v", err)
+	}
+	// By list, then rank, then ID; Order counts from 0 within each list.
+	if want := []string{"card-2", "card-3", "card-4", "card-1"}; !slices.Equal(ids(cards), want) {
+		t.Errorf("order = %v, want %v", ids(cards), want)
+	}
+	var orders []int
+	for _, c := range cards {
+		orders = append(orders, c.Order)
+	}
+	if want := []int{0, 0, 1, 2}; !slices.Equal(orders, want) {
+		t.Errorf("Order = %v, want %v", orders, want)
+	}
+}
+
+func testCardArchivedFilter(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	cards, err := r.Cards.ListByBoard(ctx, "board-1", true)
+	if err != nil {
+		t.Fatalf("ListByBoard: %v", err)
+	}
+	if cards == nil || len(cards) != 0 {
+		t.Errorf("ListByBoard of an empty board = %#v, want an empty slice", cards)
+	}
+
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-1", Title: "A", List: "todo", Rank: "a"})
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-2", Title: "B", List: "todo", Rank: "b", Archived: true})
+
+	active, _ := r.Cards.ListByBoard(ctx, "board-1", false)
+	if want := []string{"card-1"}; !slices.Equal(ids(active), want) {
+		t.Errorf("active = %v, want %v", ids(active), want)
+	}
+	all, _ := r.Cards.ListByBoard(ctx, "board-1", true)
+	if want := []string{"card-1", "card-2"}; !slices.Equal(ids(all), want) {
+		t.Errorf("all = %v, want %v", ids(all), want)
+	}
+	// Archived cards can still be fetched one by one.
+	if _, err := r.Cards.Get(ctx, "board-1", "card-2"); err != nil {
+		t.Errorf("Get archived: %v", err)
+	}
+}
+
+func testCardBoardsAreSeparate(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-1", Title: "One", List: "todo", Rank: "i"})
+	saveCard(t, r, "board-2", &domain.Card{ID: "card-1", Title: "Two", List: "todo", Rank: "i"})
+
+	if got, _ := r.Cards.Get(ctx, "board-1", "card-1"); got.Title != "One" {
+		t.Errorf("board-1 card = %q, want One", got.Title)
+	}
+	if got, _ := r.Cards.Get(ctx, "board-2", "card-1"); got.Title != "Two" {
+		t.Errorf("board-2 card = %q, want Two", got.Title)
+	}
+	if cards, _ := r.Cards.ListByBoard(ctx, "board-1", true); len(cards) != 1 {
+		t.Errorf("board-1 has %d cards, want 1", len(cards))
+	}
+}
+
+func testCardNotFound(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-1", Title: "A", List: "todo", Rank: "i"})
+
+	_, err := r.Cards.Get(ctx, "board-1", "missing")
+	wantNotFound(t, "Get missing card", err)
+	_, err = r.Cards.Get(ctx, "no-board", "card-1")
+	wantNotFound(t, "Get card of missing board", err)
+	wantNotFound(t, "Delete missing card", r.Cards.Delete(ctx, "board-1", "missing"))
+	_, err = r.Cards.Transfer(ctx, "board-1", "board-2", &domain.Card{ID: "missing", Title: "X", List: "todo"})
+	wantNotFound(t, "Transfer missing card", err)
+}
+
+func testCardDelete(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-1", Title: "A", List: "todo", Rank: "a"})
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-2", Title: "B", List: "todo", Rank: "b"})
+
+	if err := r.Cards.Delete(ctx, "board-1", "card-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	_, err := r.Cards.Get(ctx, "board-1", "card-1")
+	wantNotFound(t, "Get deleted card", err)
+	if cards, _ := r.Cards.ListByBoard(ctx, "board-1", true); !slices.Equal(ids(cards), []string{"card-2"}) {
+		t.Errorf("cards after delete = %v", ids(cards))
+	}
+}
+
+func testCardCreate(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	saveCard(t, r, "board-1", &domain.Card{ID: "existing", Title: "A", List: "todo", Rank: "a"})
+
+	next, err := r.Cards.NextID(ctx, "board-1")
+	if err != nil || next == "" || next == "existing" {
+		t.Fatalf("NextID = %q, %v", next, err)
+	}
+
+	first := &domain.Card{Title: "B", List: "todo", Rank: "b"}
+	id1, err := r.Cards.Create(ctx, "board-1", first)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if id1 == "" || first.ID != id1 {
+		t.Errorf("Create returned %q and set ID %q", id1, first.ID)
+	}
+	id2, err := r.Cards.Create(ctx, "board-1", &domain.Card{Title: "C", List: "todo", Rank: "c"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if id2 == id1 || id2 == "existing" {
+		t.Errorf("second ID %q collides", id2)
+	}
+	if got, err := r.Cards.Get(ctx, "board-1", id1); err != nil || got.Title != "B" {
+		t.Errorf("Get created = %v, %v", got, err)
+	}
+}
+
+func testCardPrefixIDs(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	board := newBoard("board-1")
+	board.IDFormat = domain.CardIDPrefix
+	board.IDPrefix = "PROJ"
+	if err := r.Boards.Save(ctx, board); err != nil {
+		t.Fatalf("Save board: %v", err)
+	}
+	saveCard(t, r, "board-1", &domain.Card{ID: "20260124-001", Title: "Old", List: "todo", Rank: "a"})
+
+	create := func() string {
+		t.Helper()
+		id, err := r.Cards.Create(ctx, "board-1", &domain.Card{Title: "New", List: "todo", Rank: "b"})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		return id
+	}
+	if id := create(); id != "PROJ-1" {
+		t.Errorf("first ID = %q, want PROJ-1", id)
+	}
+	// Numbers of deleted cards are not issued again.
+	second := create()
+	if err := r.Cards.Delete(ctx, "board-1", second); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if id := create(); id != "PROJ-3" {
+		t.Errorf("ID after delete = %q, want PROJ-3", id)
+	}
+	// Numbers taken by cards from elsewhere are skipped.
+	saveCard(t, r, "board-1", &domain.Card{ID: "PROJ-10", Title: "Merged", List: "todo", Rank: "c"})
+	if id := create(); id != "PROJ-11" {
+		t.Errorf("ID after PROJ-10 = %q, want PROJ-11", id)
+	}
+	if _, err := r.Cards.Get(ctx, "board-1", "20260124-001"); err != nil {
+		t.Errorf("card with an ID of the old format: %v", err)
+	}
+}
+
+func testCardConcurrentNextID(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	board := newBoard("board-1")
+	board.IDFormat = domain.CardIDPrefix
+	board.IDPrefix = "PROJ"
+	if err := r.Boards.Save(ctx, board); err != nil {
+		t.Fatalf("Save board: %v", err)
+	}
+
+	// Each call advances the board's counter, so concurrent calls must not
+	// share it; run with -race to catch unguarded writes.
+	ids := make([]string, 8)
+	var wg sync.WaitGroup
+	for i := range ids {
+		wg.Go(func() {
+			id, err := r.Cards.NextID(ctx, "board-1")
+			if err != nil {
+				t.Errorf("NextID: %v", err)
+			}
+			ids[i] = id
+		})
+	}
+	wg.Wait()
+
+	slices.Sort(ids)
+	if len(slices.Compact(ids)) != len(ids) {
+		t.Errorf("NextID issued the same ID twice: %v", ids)
+	}
+}
+
+func testCardULIDs(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	board := newBoard("board-1")
+	board.IDFormat = domain.CardIDULID
+	if err := r.Boards.Save(ctx, board); err != nil {
+		t.Fatalf("Save board: %v", err)
+	}
+
+	id, err := r.Cards.Create(ctx, "board-1", &domain.Card{Title: "New", List: "todo", Rank: "a"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if len(id) != 26 {
+		t.Errorf("ID = %q, want a ULID", id)
+	}
+	if got, err := r.Cards.Get(ctx, "board-1", id); err != nil || got.ID != id {
+		t.Errorf("Get = %v, %v", got, err)
+	}
+}
+
+func testCardSaveAll(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-1", Title: "old", List: "todo", Rank: "a"})
+
+	if err := r.Cards.SaveAll(ctx, nil); err != nil {
+		t.Fatalf("SaveAll of nothing: %v", err)
+	}
+	err := r.Cards.SaveAll(ctx, []domain.CardWrite{
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-1", Title: "new", List: "done", Rank: "a"}},
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-2", Title: "new", List: "todo", Rank: "b"}},
+		{BoardID: "board-2", Card: &domain.Card{ID: "card-1", Title: "new", List: "todo", Rank: "a"}},
+	})
+	if err != nil {
+		t.Fatalf("SaveAll: %v", err)
+	}
+	for _, ref := range []domain.CardRef{{Board: "board-1", ID: "card-1"}, {Board: "board-1", ID: "card-2"}, {Board: "board-2", ID: "card-1"}} {
+		if got, err := r.Cards.Get(ctx, ref.Board, ref.ID); err != nil || got.Title != "new" {
+			t.Errorf("%v = %v, %v", ref, got, err)
+		}
+	}
+}
+
+func testCardSaveAllDeletes(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	saveBoard(t, r, "board-1")
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-1", Title: "old", List: "todo", Rank: "a"})
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-2", Title: "B", List: "todo", Rank: "b"})
+
+	// A missing card to delete fails the whole call.
+	err := r.Cards.SaveAll(ctx, []domain.CardWrite{
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-1", Title: "new", List: "todo", Rank: "a"}},
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-2"}, Delete: true},
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-missing"}, Delete: true},
+	})
+	wantNotFound(t, "SaveAll deleting a missing card", err)
+	if got, err := r.Cards.Get(ctx, "board-1", "card-1"); err != nil || got.Title != "old" {
+		t.Errorf("card-1 after failed SaveAll = %v, %v; want it unchanged", got, err)
+	}
+	if _, err := r.Cards.Get(ctx, "board-1", "card-2"); err != nil {
+		t.Errorf("card-2 after failed SaveAll: %v; want it kept", err)
+	}
+
+	err = r.Cards.SaveAll(ctx, []domain.CardWrite{
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-1", Title: "new", List: "todo", Rank: "a"}},
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-2"}, Delete: true},
+	})
+	if err != nil {
+		t.Fatalf("SaveAll: %v", err)
+	}
+	if got, err := r.Cards.Get(ctx, "board-1", "card-1"); err != nil || got.Title != "new" {
+		t.Errorf("card-1 = %v, %v", got, err)
+	}
+	_, err = r.Cards.Get(ctx, "board-1", "card-2")
+	wantNotFound(t, "Get deleted card", err)
+
+	if r.Trash == nil {
+		return
+	}
+	items, err := r.Trash.List(ctx)
+	if err != nil || len(items) != 1 || items[0].CardID != "card-2" || items[0].Name != "B" {
+		t.Errorf("trash = %+v, %v; want card-2", items, err)
+	}
+}
+
+func testCardTransfer(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	created := time.Date(2026, 1, 24, 10, 0, 0, 0, time.UTC)
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-1", Title: "A", List: "todo", Rank: "a", CreatedAt: created})
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-2", Title: "B", List: "todo", Rank: "b"})
+	saveCard(t, r, "board-2", &domain.Card{ID: "card-2", Title: "Other", List: "todo", Rank: "a"})
+
+	card, _ := r.Cards.Get(ctx, "board-1", "card-1")
+	id, err := r.Cards.Transfer(ctx, "board-1", "board-2", card)
+	if err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+	if id != "card-1" || card.ID != "card-1" {
+		t.Errorf("Transfer kept ID %q (card %q), want card-1", id, card.ID)
+	}
+	_, err = r.Cards.Get(ctx, "board-1", "card-1")
+	wantNotFound(t, "Get transferred card on source", err)
+	if got, err := r.Cards.Get(ctx, "board-2", "card-1"); err != nil || !got.CreatedAt.Equal(created) {
+		t.Errorf("transferred card = %v, %v", got, err)
+	}
+
+	// card-2 is taken on board-2, so the moved card gets a new ID.
+	card, _ = r.Cards.Get(ctx, "board-1", "card-2")
+	id, err = r.Cards.Transfer(ctx, "board-1", "board-2", card)
+	if err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+	if id == "card-2" || card.ID != id {
+		t.Errorf("Transfer returned %q (card %q), want a new ID", id, card.ID)
+	}
+	if got, _ := r.Cards.Get(ctx, "board-2", "card-2"); got.Title != "Other" {
+		t.Errorf("existing card-2 on board-2 was overwritten: %q", got.Title)
+	}
+	if got, err := r.Cards.Get(ctx, "board-2", id); err != nil || got.Title != "B" {
+		t.Errorf("renamed card = %v, %v", got, err)
+	}
+}
+
+// addCardFiles gives a card a comment and an attachment, skipping the test if
+// the repositories have none.
+func addCardFiles(t *testing.T, r Repositories, boardID, cardID string) {
+	t.Helper()
+	if r.Comments == nil || r.Attachments == nil {
+		t.Skip("no comment and attachment repositories")
+	}
+	ctx := context.Background()
+	if _, err := r.Comments.Create(ctx, boardID, cardID, &domain.Comment{Author: "a", Body: "note"}); err != nil {
+		t.Fatalf("Create comment: %v", err)
+	}
+	if _, err := r.Attachments.Put(ctx, boardID, cardID, "att-1", strings.NewReader("data")); err != nil {
+		t.Fatalf("Put attachment: %v", err)
+	}
+}
+
+// wantCardFiles checks whether the card has the comment and attachment of
+// addCardFiles.
+func wantCardFiles(t *testing.T, r Repositories, boardID, cardID string, want bool) {
+	t.Helper()
+	ctx := context.Background()
+	comments, err := r.Comments.ListByCard(ctx, boardID, cardID)
+	if err != nil {
+		t.Fatalf("ListByCard %s/%s: %v", boardID, cardID, err)
+	}
+	if got := len(comments) == 1 && comments[0].Body == "note"; got != want {
+		t.Errorf("comments of %s/%s = %+v, want the note: %v", boardID, cardID, comments, want)
+	}
+
+	rc, err := r.Attachments.Open(ctx, boardID, cardID, "att-1")
+	if !want {
+		wantNotFound(t, "Open attachment of "+boardID+"/"+cardID, err)
+		if err == nil {
+			_ = rc.Close()
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("Open attachment of %s/%s: %v", boardID, cardID, err)
+	}
+	defer rc.Close()
+	if data, _ := io.ReadAll(rc); string(data) != "data" {
+		t.Errorf("attachment of %s/%s = %q", boardID, cardID, data)
+	}
+}
+
+func testCardTransferMovesFiles(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	saveBoard(t, r, "board-1")
+	saveBoard(t, r, "board-2")
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-1", Title: "A", List: "todo", Rank: "a"})
+	saveCard(t, r, "board-2", &domain.Card{ID: "card-1", Title: "Taken", List: "todo", Rank: "a"})
+	addCardFiles(t, r, "board-1", "card-1")
+
+	card, _ := r.Cards.Get(ctx, "board-1", "card-1")
+	id, err := r.Cards.Transfer(ctx, "board-1", "board-2", card)
+	if err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+	wantCardFiles(t, r, "board-2", id, true)
+	wantCardFiles(t, r, "board-1", "card-1", false)
+	// The card that had the ID on the target board keeps none of them.
+	wantCardFiles(t, r, "board-2", "card-1", false)
+}
+
+func testCardDeleteTrashesFiles(t *testing.T, r Repositories) {
+	ctx := context.Background()
+	saveBoard(t, r, "board-1")
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-1", Title: "A", List: "todo", Rank: "a"})
+	addCardFiles(t, r, "board-1", "card-1")
+
+	if err := r.Cards.Delete(ctx, "board-1", "card-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	// A card given the deleted card's ID starts without them.
+	saveCard(t, r, "board-1", &domain.Card{ID: "card-1", Title: "Reused", List: "todo", Rank: "a"})
+	wantCardFiles(t, r, "board-1", "card-1", false)
+
+	if r.Trash == nil {
+		return
+	}
+	items, err := r.Trash.List(ctx)
+	if err != nil || len(items) != 1 {
+		t.Fatalf("trash = %+v, %v; want the deleted card", items, err)
+	}
+	restored, err := r.Trash.Restore(ctx, items[0].ID)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if restored.CardID == "card-1" {
+		t.Fatalf("restored card took the reused ID")
+	}
+	wantCardFiles(t, r, "board-1", restored.CardID, true)
+	wantCardFiles(t, r, "board-1", "card-1", false)
+}
@@ -0,0 +1,49 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Runner performs the periodic work, e.g. materializing due recurring cards.
+type Runner interface {
+	RunDue(ctx context.Context, now time.Time) error
+}
+
+type Scheduler struct {
+	runner   Runner
+	interval time.Duration
+	now      func() time.Time
+}
+
+func New(runner Runner, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		runner:   runner,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Start runs the runner once immediately, so that occurrences missed while the
+// server was down are caught up, and then on every tick until ctx is canceled.
+func (s *Scheduler) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.run(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			s.run(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	if err := s.runner.RunDue(ctx, s.now()); err != nil {
+		slog.Error("scheduler: run failed", "error", err)
+	}
+}
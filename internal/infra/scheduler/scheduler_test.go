@@ -0,0 +1,52 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/scheduler"
+)
+
+type mockRunner struct {
+	mu    sync.Mutex
+	calls int
+	err   error
+}
+
+func (m *mockRunner) RunDue(_ context.Context, _ time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	return m.err
+}
+
+func (m *mockRunner) getCalls() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls
+}
+
+func TestScheduler_Start(t *testing.T) {
+	runner := &mockRunner{err: errors.New("boom")}
+	s := scheduler.New(runner, 50*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.Start(ctx)
+	}()
+
+	time.Sleep(180 * time.Millisecond)
+	cancel()
+
+	if err := <-errCh; !errors.Is(err, context.Canceled) {
+		t.Errorf("Start() error = %v, want context.Canceled", err)
+	}
+	// One immediate run plus at least two ticks; errors must not stop the loop.
+	if got := runner.getCalls(); got < 3 {
+		t.Errorf("calls = %d, want at least 3", got)
+	}
+}
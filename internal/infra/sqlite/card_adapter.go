@@ -0,0 +1,48 @@
+package sqlite
+
+import (
+	"context"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// CardRepositoryAdapter adapts Store to satisfy domain.CardRepository interface.
+type CardRepositoryAdapter struct {
+	store *Store
+}
+
+func NewCardRepositoryAdapter(store *Store) *CardRepositoryAdapter {
+	return &CardRepositoryAdapter{store: store}
+}
+
+func (a *CardRepositoryAdapter) ListByBoard(ctx context.Context, boardID string, includeArchived bool) ([]domain.Card, error) {
+	return a.store.ListByBoard(ctx, boardID, includeArchived)
+}
+
+func (a *CardRepositoryAdapter) Get(ctx context.Context, boardID, cardID string) (*domain.Card, error) {
+	return a.store.GetCard(ctx, boardID, cardID)
+}
+
+func (a *CardRepositoryAdapter) Save(ctx context.Context, boardID string, card *domain.Card) error {
+	return a.store.SaveCard(ctx, boardID, card)
+}
+
+func (a *CardRepositoryAdapter) SaveAll(ctx context.Context, writes []domain.CardWrite) error {
+	return a.store.SaveCards(ctx, writes)
+}
+
+func (a *CardRepositoryAdapter) Delete(ctx context.Context, boardID, cardID string) error {
+	return a.store.DeleteCard(ctx, boardID, cardID)
+}
+
+func (a *CardRepositoryAdapter) NextID(ctx context.Context, boardID string) (string, error) {
+	return a.store.NextID(ctx, boardID)
+}
+
+func (a *CardRepositoryAdapter) Create(ctx context.Context, boardID string, card *domain.Card) (string, error) {
+	return a.store.CreateCard(ctx, boardID, card)
+}
+
+func (a *CardRepositoryAdapter) Transfer(ctx context.Context, fromBoardID, toBoardID string, card *domain.Card) (string, error) {
+	return a.store.TransferCard(ctx, fromBoardID, toBoardID, card)
+}
@@ -0,0 +1,550 @@
+// Package sqlite stores boards and cards in a SQLite database, for boards too
+// large for one file per card. Comments, attachments and the other per-board
+// data stay in the .tasks directory; the store moves them through a
+// domain.CardFileRepository when it moves or trashes cards and boards.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// migrations builds the schema one step at a time. The database records how
+// many have run in PRAGMA user_version; new steps are only ever appended.
+var migrations = []string{
+	`CREATE TABLE boards (
+		id   TEXT PRIMARY KEY,
+		data TEXT NOT NULL
+	)`,
+	`CREATE TABLE cards (
+		board_id TEXT NOT NULL,
+		id       TEXT NOT NULL,
+		archived INTEGER NOT NULL DEFAULT 0,
+		data     TEXT NOT NULL,
+		PRIMARY KEY (board_id, id)
+	)`,
+	`CREATE TABLE trash (
+		id         TEXT PRIMARY KEY,
+		kind       TEXT NOT NULL,
+		board_id   TEXT NOT NULL,
+		card_id    TEXT NOT NULL DEFAULT '',
+		name       TEXT NOT NULL,
+		deleted_at TEXT NOT NULL,
+		data       TEXT NOT NULL
+	)`,
+	`CREATE TABLE id_counters (
+		board_id TEXT PRIMARY KEY,
+		counter  INTEGER NOT NULL
+	)`,
+}
+
+// Store keeps each board and card as a JSON document in its own row, with
+// the columns needed to select them alongside.
+type Store struct {
+	db    *sql.DB
+	files domain.CardFileRepository
+}
+
+// Open opens or creates the database at path and brings its schema up to date.
+// files holds the comments and attachments of the cards; nil is for a store
+// whose cards have none, such as one being copied to.
+func Open(path string, files domain.CardFileRepository) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	// A single connection serializes writers, which SQLite would otherwise
+	// answer with SQLITE_BUSY.
+	db.SetMaxOpenConns(1)
+
+	if files == nil {
+		files = noCardFiles{}
+	}
+	s := &Store{db: db, files: files}
+	if err := s.migrate(context.Background()); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// SchemaVersion returns the number of migrations applied to the database.
+func (s *Store) SchemaVersion(ctx context.Context) (int, error) {
+	var version int
+	if err := s.db.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return 0, fmt.Errorf("read schema version: %w", err)
+	}
+	return version, nil
+}
+
+func (s *Store) migrate(ctx context.Context) error {
+	version, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("database schema version %d is newer than this build supports (%d)", version, len(migrations))
+	}
+	for i := version; i < len(migrations); i++ {
+		err := s.inTx(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, migrations[i]); err != nil {
+				return err
+			}
+			// PRAGMA does not take parameters.
+			_, err := tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", i+1))
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("apply migration %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// inTx runs fn in a transaction, committing if it returns nil.
+func (s *Store) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	return s.inTxMovingFiles(ctx, fn, nil)
+}
+
+// inTxMovingFiles is inTx for a change that also moves the files kept beside
+// cards. fn moves them as its last step, so that failing to move them rolls
+// the rows back; if the commit then fails, undo moves them back.
+func (s *Store) inTxMovingFiles(ctx context.Context, fn func(tx *sql.Tx) error, undo func()) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		if undo != nil {
+			undo()
+		}
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// BoardRepository implementation
+
+func (s *Store) List(ctx context.Context) ([]domain.Board, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT data FROM boards ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("list boards: %w", err)
+	}
+	defer rows.Close()
+
+	boards := []domain.Board{}
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("scan board: %w", err)
+		}
+		var board domain.Board
+		if err := json.Unmarshal([]byte(data), &board); err != nil {
+			return nil, fmt.Errorf("unmarshal board: %w", err)
+		}
+		boards = append(boards, board)
+	}
+	return boards, rows.Err()
+}
+
+func (s *Store) Get(ctx context.Context, id string) (*domain.Board, error) {
+	return getBoard(ctx, s.db, id)
+}
+
+func (s *Store) Save(ctx context.Context, board *domain.Board) error {
+	return saveBoard(ctx, s.db, board)
+}
+
+func (s *Store) Delete(ctx context.Context, id string) error {
+	var itemID string
+	return s.inTxMovingFiles(ctx, func(tx *sql.Tx) error {
+		board, err := getBoard(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		cards, err := listCards(ctx, tx, id, true)
+		if err != nil {
+			return err
+		}
+		item := &domain.TrashItem{Kind: domain.TrashBoard, BoardID: id, Name: board.Name, DeletedAt: time.Now()}
+		if err := createTrashItem(ctx, tx, item, trashedBoard{Board: board, Cards: cards}); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM cards WHERE board_id = ?", id); err != nil {
+			return fmt.Errorf("delete cards: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM boards WHERE id = ?", id); err != nil {
+			return fmt.Errorf("delete board: %w", err)
+		}
+		itemID = item.ID
+		return s.files.TrashBoardFiles(ctx, id, item.ID)
+	}, func() {
+		_ = s.files.RestoreBoardFiles(ctx, itemID, id)
+	})
+}
+
+// CardRepository implementation
+
+func (s *Store) ListByBoard(ctx context.Context, boardID string, includeArchived bool) ([]domain.Card, error) {
+	cards, err := listCards(ctx, s.db, boardID, includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	domain.SortByRank(cards)
+	return cards, nil
+}
+
+func (s *Store) GetCard(ctx context.Context, boardID, cardID string) (*domain.Card, error) {
+	return getCard(ctx, s.db, boardID, cardID)
+}
+
+func (s *Store) SaveCard(ctx context.Context, boardID string, card *domain.Card) error {
+	return saveCard(ctx, s.db, boardID, card)
+}
+
+// SaveCards saves and deletes all the cards in one transaction. The files of
+// deleted cards are moved to the trash after every row is written.
+func (s *Store) SaveCards(ctx context.Context, writes []domain.CardWrite) error {
+	var trashed []trashedCardRef
+	return s.inTxMovingFiles(ctx, func(tx *sql.Tx) error {
+		for _, w := range writes {
+			if !w.Delete {
+				if err := saveCard(ctx, tx, w.BoardID, w.Card); err != nil {
+					return err
+				}
+				continue
+			}
+			t, err := trashCard(ctx, tx, w.BoardID, w.Card.ID)
+			if err != nil {
+				return err
+			}
+			trashed = append(trashed, t)
+		}
+		return s.trashCardFiles(ctx, trashed)
+	}, func() {
+		s.restoreCardFiles(ctx, trashed)
+	})
+}
+
+// DeleteCard moves the card to the trash, with its comments and attachments,
+// so that a card later given the same ID does not inherit them.
+func (s *Store) DeleteCard(ctx context.Context, boardID, cardID string) error {
+	var trashed []trashedCardRef
+	return s.inTxMovingFiles(ctx, func(tx *sql.Tx) error {
+		t, err := trashCard(ctx, tx, boardID, cardID)
+		if err != nil {
+			return err
+		}
+		trashed = append(trashed, t)
+		return s.trashCardFiles(ctx, trashed)
+	}, func() {
+		s.restoreCardFiles(ctx, trashed)
+	})
+}
+
+// trashedCardRef is a card moved to the trash item itemID, whose files are yet
+// to follow it.
+type trashedCardRef struct {
+	ref    domain.CardRef
+	itemID string
+}
+
+// trashCard moves the card's row to a new trash item.
+func trashCard(ctx context.Context, tx *sql.Tx, boardID, cardID string) (trashedCardRef, error) {
+	card, err := getCard(ctx, tx, boardID, cardID)
+	if err != nil {
+		return trashedCardRef{}, err
+	}
+	item := &domain.TrashItem{Kind: domain.TrashCard, BoardID: boardID, CardID: cardID, Name: card.Title, DeletedAt: time.Now()}
+	if err := createTrashItem(ctx, tx, item, card); err != nil {
+		return trashedCardRef{}, err
+	}
+	if err := deleteCard(ctx, tx, boardID, cardID); err != nil {
+		return trashedCardRef{}, err
+	}
+	return trashedCardRef{ref: domain.CardRef{Board: boardID, ID: cardID}, itemID: item.ID}, nil
+}
+
+// trashCardFiles moves the files of the trashed cards to their trash items,
+// all or none.
+func (s *Store) trashCardFiles(ctx context.Context, trashed []trashedCardRef) error {
+	for i, t := range trashed {
+		if err := s.files.TrashCardFiles(ctx, t.ref, t.itemID); err != nil {
+			s.restoreCardFiles(ctx, trashed[:i])
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreCardFiles moves the files of the trashed cards back.
+func (s *Store) restoreCardFiles(ctx context.Context, trashed []trashedCardRef) {
+	for _, t := range trashed {
+		_ = s.files.RestoreCardFiles(ctx, t.itemID, t.ref)
+	}
+}
+
+func (s *Store) NextID(ctx context.Context, boardID string) (string, error) {
+	var id string
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		id, err = nextID(ctx, tx, boardID)
+		return err
+	})
+	return id, err
+}
+
+func (s *Store) CreateCard(ctx context.Context, boardID string, card *domain.Card) (string, error) {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		id, err := nextID(ctx, tx, boardID)
+		if err != nil {
+			return err
+		}
+		card.ID = id
+		return saveCard(ctx, tx, boardID, card)
+	})
+	if err != nil {
+		return "", err
+	}
+	return card.ID, nil
+}
+
+// TransferCard moves the card row to toBoardID, and its comments and
+// attachments with it.
+func (s *Store) TransferCard(ctx context.Context, fromBoardID, toBoardID string, card *domain.Card) (string, error) {
+	oldID := card.ID
+	from := domain.CardRef{Board: fromBoardID, ID: oldID}
+	err := s.inTxMovingFiles(ctx, func(tx *sql.Tx) error {
+		if _, err := getCard(ctx, tx, fromBoardID, oldID); err != nil {
+			return err
+		}
+		newID := oldID
+		if _, err := getCard(ctx, tx, toBoardID, oldID); err == nil {
+			id, err := nextID(ctx, tx, toBoardID)
+			if err != nil {
+				return err
+			}
+			newID = id
+		}
+		card.ID = newID
+		if err := saveCard(ctx, tx, toBoardID, card); err != nil {
+			return err
+		}
+		if err := deleteCard(ctx, tx, fromBoardID, oldID); err != nil {
+			return err
+		}
+		return s.files.MoveCardFiles(ctx, from, domain.CardRef{Board: toBoardID, ID: newID})
+	}, func() {
+		_ = s.files.MoveCardFiles(ctx, domain.CardRef{Board: toBoardID, ID: card.ID}, from)
+	})
+	if err != nil {
+		card.ID = oldID
+		return "", err
+	}
+	return card.ID, nil
+}
+
+// querier is what both *sql.DB and *sql.Tx offer.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func getBoard(ctx context.Context, q querier, id string) (*domain.Board, error) {
+	var data string
+	err := q.QueryRowContext(ctx, "SELECT data FROM boards WHERE id = ?", id).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &domain.ErrNotFound{Resource: "board", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get board: %w", err)
+	}
+	var board domain.Board
+	if err := json.Unmarshal([]byte(data), &board); err != nil {
+		return nil, fmt.Errorf("unmarshal board: %w", err)
+	}
+	return &board, nil
+}
+
+func saveBoard(ctx context.Context, q querier, board *domain.Board) error {
+	data, err := json.Marshal(board)
+	if err != nil {
+		return fmt.Errorf("marshal board: %w", err)
+	}
+	_, err = q.ExecContext(ctx,
+		"INSERT INTO boards (id, data) VALUES (?, ?) ON CONFLICT (id) DO UPDATE SET data = excluded.data",
+		board.ID, string(data))
+	if err != nil {
+		return fmt.Errorf("save board: %w", err)
+	}
+	return nil
+}
+
+// listCards returns the cards of a board in no particular order.
+func listCards(ctx context.Context, q querier, boardID string, includeArchived bool) ([]domain.Card, error) {
+	query := "SELECT data FROM cards WHERE board_id = ?"
+	if !includeArchived {
+		query += " AND archived = 0"
+	}
+	rows, err := q.QueryContext(ctx, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("list cards: %w", err)
+	}
+	defer rows.Close()
+
+	cards := []domain.Card{}
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("scan card: %w", err)
+		}
+		card, err := unmarshalCard(data)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, *card)
+	}
+	return cards, rows.Err()
+}
+
+func getCard(ctx context.Context, q querier, boardID, cardID string) (*domain.Card, error) {
+	var data string
+	err := q.QueryRowContext(ctx, "SELECT data FROM cards WHERE board_id = ? AND id = ?", boardID, cardID).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, &domain.ErrNotFound{Resource: "card", ID: cardID}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get card: %w", err)
+	}
+	return unmarshalCard(data)
+}
+
+func saveCard(ctx context.Context, q querier, boardID string, card *domain.Card) error {
+	data, err := marshalCard(card)
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx,
+		`INSERT INTO cards (board_id, id, archived, data) VALUES (?, ?, ?, ?)
+		ON CONFLICT (board_id, id) DO UPDATE SET archived = excluded.archived, data = excluded.data`,
+		boardID, card.ID, card.Archived, data)
+	if err != nil {
+		return fmt.Errorf("save card: %w", err)
+	}
+	return nil
+}
+
+func deleteCard(ctx context.Context, q querier, boardID, cardID string) error {
+	if _, err := q.ExecContext(ctx, "DELETE FROM cards WHERE board_id = ? AND id = ?", boardID, cardID); err != nil {
+		return fmt.Errorf("delete card: %w", err)
+	}
+	return nil
+}
+
+// nextID returns a new card ID in the board's ID format. On a prefix board
+// it also advances the board's row in id_counters.
+func nextID(ctx context.Context, q querier, boardID string) (string, error) {
+	rows, err := q.QueryContext(ctx, "SELECT id FROM cards WHERE board_id = ?", boardID)
+	if err != nil {
+		return "", fmt.Errorf("list card ids: %w", err)
+	}
+	defer rows.Close()
+	var taken []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return "", fmt.Errorf("scan card id: %w", err)
+		}
+		taken = append(taken, id)
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+
+	board, err := getBoard(ctx, q, boardID)
+	if err != nil {
+		board = nil
+	}
+	counter, err := readIDCounter(ctx, q, boardID)
+	if err != nil {
+		return "", err
+	}
+
+	id, next := domain.NextCardID(board, taken, counter, time.Now())
+	if next != counter {
+		if err := writeIDCounter(ctx, q, boardID, next); err != nil {
+			return "", err
+		}
+	}
+	return id, nil
+}
+
+func readIDCounter(ctx context.Context, q querier, boardID string) (int, error) {
+	var counter int
+	err := q.QueryRowContext(ctx, "SELECT counter FROM id_counters WHERE board_id = ?", boardID).Scan(&counter)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("read id counter: %w", err)
+	}
+	return counter, nil
+}
+
+func writeIDCounter(ctx context.Context, q querier, boardID string, counter int) error {
+	_, err := q.ExecContext(ctx,
+		"INSERT INTO id_counters (board_id, counter) VALUES (?, ?) ON CONFLICT (board_id) DO UPDATE SET counter = excluded.counter",
+		boardID, counter)
+	if err != nil {
+		return fmt.Errorf("write id counter: %w", err)
+	}
+	return nil
+}
+
+// IDCounterRepository implementation
+
+func (s *Store) IDCounter(ctx context.Context, boardID string) (int, error) {
+	return readIDCounter(ctx, s.db, boardID)
+}
+
+func (s *Store) SetIDCounter(ctx context.Context, boardID string, counter int) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		if _, err := getBoard(ctx, tx, boardID); err != nil {
+			return err
+		}
+		return writeIDCounter(ctx, tx, boardID, counter)
+	})
+}
+
+// marshalCard encodes a card without its Order, which is derived from Rank.
+func marshalCard(card *domain.Card) (string, error) {
+	c := *card
+	c.Order = 0
+	data, err := json.Marshal(&c)
+	if err != nil {
+		return "", fmt.Errorf("marshal card: %w", err)
+	}
+	return string(data), nil
+}
+
+func unmarshalCard(data string) (*domain.Card, error) {
+	var card domain.Card
+	if err := json.Unmarshal([]byte(data), &card); err != nil {
+		return nil, fmt.Errorf("unmarshal card: %w", err)
+	}
+	return &card, nil
+}
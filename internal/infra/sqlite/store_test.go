@@ -0,0 +1,304 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/repotest"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/sqlite"
+	yamlstore "github.com/hiroto-aibara/secretary-ai/internal/infra/yaml"
+)
+
+func setupStore(t *testing.T) *sqlite.Store {
+	t.Helper()
+	store, err := sqlite.Open(filepath.Join(t.TempDir(), "tasks.db"), nil)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func TestStore_Contract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repositories {
+		// Comments and attachments stay in the .tasks directory.
+		files := yamlstore.NewStore(t.TempDir())
+		store, err := sqlite.Open(filepath.Join(t.TempDir(), "tasks.db"), files)
+		if err != nil {
+			t.Fatalf("Open: %v", err)
+		}
+		t.Cleanup(func() { _ = store.Close() })
+		return repotest.Repositories{
+			Boards:      store,
+			Cards:       sqlite.NewCardRepositoryAdapter(store),
+			Comments:    yamlstore.NewCommentRepositoryAdapter(files),
+			Attachments: yamlstore.NewAttachmentRepositoryAdapter(files),
+			Trash:       sqlite.NewTrashRepositoryAdapter(store),
+		}
+	})
+}
+
+func TestStore_Migrations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tasks.db")
+	ctx := context.Background()
+
+	store, err := sqlite.Open(path, nil)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	v1, err := store.SchemaVersion(ctx)
+	if err != nil || v1 == 0 {
+		t.Fatalf("SchemaVersion = %d, %v", v1, err)
+	}
+	if err := store.Save(ctx, &domain.Board{ID: "board-1", Name: "B", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	_ = store.Close()
+
+	// Reopening runs no migration twice and keeps the data.
+	store, err = sqlite.Open(path, nil)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer store.Close()
+	if v2, _ := store.SchemaVersion(ctx); v2 != v1 {
+		t.Errorf("version = %d after reopening, want %d", v2, v1)
+	}
+	if _, err := store.Get(ctx, "board-1"); err != nil {
+		t.Errorf("Get after reopening: %v", err)
+	}
+}
+
+func TestStore_Board_CRUD(t *testing.T) {
+	store := setupStore(t)
+	ctx := context.Background()
+
+	board := &domain.Board{ID: "board-1", Name: "Board", Lists: []domain.List{{ID: "todo", Name: "Todo"}}, Archived: true}
+	if err := store.Save(ctx, board); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	got, err := store.Get(ctx, "board-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Name != "Board" || len(got.Lists) != 1 || !got.Archived {
+		t.Errorf("board = %+v", got)
+	}
+
+	board.Name = "Renamed"
+	if err := store.Save(ctx, board); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	boards, err := store.List(ctx)
+	if err != nil || len(boards) != 1 || boards[0].Name != "Renamed" {
+		t.Errorf("List = %v, %v", boards, err)
+	}
+
+	var notFound *domain.ErrNotFound
+	if _, err := store.Get(ctx, "missing"); !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if err := store.Delete(ctx, "missing"); !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestStore_Card_CRUD(t *testing.T) {
+	store := setupStore(t)
+	cards := sqlite.NewCardRepositoryAdapter(store)
+	ctx := context.Background()
+
+	created := time.Date(2026, 1, 24, 10, 0, 0, 0, time.UTC)
+	card := &domain.Card{
+		Title: "Task", List: "todo", Rank: "i", Labels: []string{"bug"},
+		Fields: map[string]any{"points": 3.0}, CreatedAt: created, UpdatedAt: created,
+	}
+	id, err := cards.Create(ctx, "board-1", card)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if want := time.Now().Format("20060102") + "-001"; id != want {
+		t.Errorf("id = %s, want %s", id, want)
+	}
+	if next, _ := cards.NextID(ctx, "board-1"); next != time.Now().Format("20060102")+"-002" {
+		t.Errorf("NextID = %s", next)
+	}
+
+	got, err := cards.Get(ctx, "board-1", id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Title != "Task" || got.Labels[0] != "bug" || got.Fields["points"] != 3.0 || !got.CreatedAt.Equal(created) {
+		t.Errorf("card = %+v", got)
+	}
+
+	if err := cards.Save(ctx, "board-1", &domain.Card{ID: "old", Title: "Old", List: "todo", Rank: "a", Archived: true}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	active, _ := cards.ListByBoard(ctx, "board-1", false)
+	all, _ := cards.ListByBoard(ctx, "board-1", true)
+	if len(active) != 1 || len(all) != 2 {
+		t.Errorf("active = %d, all = %d; want 1 and 2", len(active), len(all))
+	}
+	if all[0].ID != "old" || all[1].Order != 1 {
+		t.Errorf("cards are not sorted by rank: %v", all)
+	}
+
+	if err := cards.Delete(ctx, "board-1", id); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	var notFound *domain.ErrNotFound
+	if _, err := cards.Get(ctx, "board-1", id); !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestStore_SaveAll(t *testing.T) {
+	store := setupStore(t)
+	cards := sqlite.NewCardRepositoryAdapter(store)
+	ctx := context.Background()
+
+	err := cards.SaveAll(ctx, []domain.CardWrite{
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-1", Title: "A", List: "todo"}},
+		{BoardID: "board-2", Card: &domain.Card{ID: "card-1", Title: "B", List: "todo"}},
+	})
+	if err != nil {
+		t.Fatalf("SaveAll: %v", err)
+	}
+	for _, board := range []string{"board-1", "board-2"} {
+		if _, err := cards.Get(ctx, board, "card-1"); err != nil {
+			t.Errorf("%s: %v", board, err)
+		}
+	}
+
+	// A cancelled context fails the transaction before anything is written.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	err = cards.SaveAll(cancelled, []domain.CardWrite{
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-1", Title: "changed", List: "todo"}},
+	})
+	if err == nil {
+		t.Fatal("expected SaveAll to fail")
+	}
+	if got, _ := cards.Get(ctx, "board-1", "card-1"); got.Title != "A" {
+		t.Errorf("title = %q, want A", got.Title)
+	}
+}
+
+func TestStore_TransferCard(t *testing.T) {
+	store := setupStore(t)
+	cards := sqlite.NewCardRepositoryAdapter(store)
+	ctx := context.Background()
+
+	for _, c := range []struct{ board, id string }{{"board-1", "card-1"}, {"board-1", "card-2"}, {"board-2", "card-2"}} {
+		if err := cards.Save(ctx, c.board, &domain.Card{ID: c.id, Title: c.id, List: "todo"}); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+
+	card, _ := cards.Get(ctx, "board-1", "card-1")
+	if id, err := cards.Transfer(ctx, "board-1", "board-2", card); err != nil || id != "card-1" {
+		t.Fatalf("Transfer = %s, %v", id, err)
+	}
+	if _, err := cards.Get(ctx, "board-1", "card-1"); err == nil {
+		t.Error("expected card to be removed from the source board")
+	}
+
+	// card-2 already exists on board-2, so a new ID is assigned.
+	card, _ = cards.Get(ctx, "board-1", "card-2")
+	id, err := cards.Transfer(ctx, "board-1", "board-2", card)
+	if err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+	if id == "card-2" {
+		t.Error("expected a new ID")
+	}
+
+	var notFound *domain.ErrNotFound
+	if _, err := cards.Transfer(ctx, "board-1", "board-2", &domain.Card{ID: "missing"}); !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestStore_Trash(t *testing.T) {
+	store := setupStore(t)
+	cards := sqlite.NewCardRepositoryAdapter(store)
+	trash := sqlite.NewTrashRepositoryAdapter(store)
+	ctx := context.Background()
+
+	if err := store.Save(ctx, &domain.Board{ID: "board-1", Name: "Board", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	for _, id := range []string{"card-1", "card-2"} {
+		if err := cards.Save(ctx, "board-1", &domain.Card{ID: id, Title: "Title " + id, List: "todo"}); err != nil {
+			t.Fatalf("Save card: %v", err)
+		}
+	}
+
+	if err := cards.Delete(ctx, "board-1", "card-1"); err != nil {
+		t.Fatalf("Delete card: %v", err)
+	}
+	// The ID is reused before the card is restored.
+	if err := cards.Save(ctx, "board-1", &domain.Card{ID: "card-1", Title: "Newer", List: "todo"}); err != nil {
+		t.Fatalf("Save card: %v", err)
+	}
+	items, err := trash.List(ctx)
+	if err != nil || len(items) != 1 || items[0].Kind != domain.TrashCard || items[0].Name != "Title card-1" {
+		t.Fatalf("List = %v, %v", items, err)
+	}
+	restored, err := trash.Restore(ctx, items[0].ID)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if restored.CardID == "card-1" {
+		t.Error("expected the restored card to get a new ID")
+	}
+	if got, err := cards.Get(ctx, "board-1", restored.CardID); err != nil || got.Title != "Title card-1" {
+		t.Errorf("restored card = %v, %v", got, err)
+	}
+
+	if err := store.Delete(ctx, "board-1"); err != nil {
+		t.Fatalf("Delete board: %v", err)
+	}
+	if left, _ := cards.ListByBoard(ctx, "board-1", true); len(left) != 0 {
+		t.Errorf("cards left on deleted board: %v", left)
+	}
+	items, _ = trash.List(ctx)
+	if len(items) != 1 || items[0].Kind != domain.TrashBoard {
+		t.Fatalf("List = %v", items)
+	}
+
+	// A board is not restored over one with the same ID.
+	if err := store.Save(ctx, &domain.Board{ID: "board-1", Name: "Other", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	var conflict *domain.ErrConflict
+	if _, err := trash.Restore(ctx, items[0].ID); !errors.As(err, &conflict) {
+		t.Errorf("expected ErrConflict, got %v", err)
+	}
+	if err := store.Delete(ctx, "board-1"); err != nil {
+		t.Fatalf("Delete board: %v", err)
+	}
+	if _, err := trash.Restore(ctx, items[0].ID); err != nil {
+		t.Fatalf("Restore board: %v", err)
+	}
+	if all, _ := cards.ListByBoard(ctx, "board-1", true); len(all) != 3 {
+		t.Errorf("restored board has %d cards, want 3", len(all))
+	}
+
+	items, _ = trash.List(ctx)
+	if len(items) != 1 {
+		t.Fatalf("List = %v", items)
+	}
+	if err := trash.Purge(ctx, items[0].ID); err != nil {
+		t.Fatalf("Purge: %v", err)
+	}
+	var notFound *domain.ErrNotFound
+	if err := trash.Purge(ctx, items[0].ID); !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,293 @@
+package sqlite
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// A trashed item is a row of the trash table whose data holds the deleted
+// card, or the deleted board with all its cards.
+
+// timeLayout stores times with fixed width in UTC, so that they sort as text.
+const timeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+type trashedBoard struct {
+	Board *domain.Board `json:"board"`
+	Cards []domain.Card `json:"cards"`
+}
+
+// TrashRepository implementation
+
+// ListTrash returns the trashed items, most recently deleted first.
+func (s *Store) ListTrash(ctx context.Context) ([]domain.TrashItem, error) {
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT id, kind, board_id, card_id, name, deleted_at FROM trash ORDER BY deleted_at DESC, id DESC")
+	if err != nil {
+		return nil, fmt.Errorf("list trash: %w", err)
+	}
+	defer rows.Close()
+
+	items := []domain.TrashItem{}
+	for rows.Next() {
+		item, err := scanTrashItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *item)
+	}
+	return items, rows.Err()
+}
+
+// RestoreTrash puts a trashed item back, with the files kept beside its
+// cards. A board is not restored over a board with the same ID, and a card
+// needs its board to exist.
+func (s *Store) RestoreTrash(ctx context.Context, itemID string) (*domain.TrashItem, error) {
+	var item *domain.TrashItem
+	err := s.inTxMovingFiles(ctx, func(tx *sql.Tx) error {
+		var data string
+		var err error
+		item, data, err = getTrashItem(ctx, tx, itemID)
+		if err != nil {
+			return err
+		}
+
+		switch item.Kind {
+		case domain.TrashBoard:
+			if err := restoreBoard(ctx, tx, item, data); err != nil {
+				return err
+			}
+		case domain.TrashCard:
+			if err := restoreCard(ctx, tx, item, data); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown trash item kind %q", item.Kind)
+		}
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM trash WHERE id = ?", itemID); err != nil {
+			return fmt.Errorf("remove trash item: %w", err)
+		}
+		if item.Kind == domain.TrashBoard {
+			return s.files.RestoreBoardFiles(ctx, itemID, item.BoardID)
+		}
+		return s.files.RestoreCardFiles(ctx, itemID, domain.CardRef{Board: item.BoardID, ID: item.CardID})
+	}, func() {
+		if item.Kind == domain.TrashBoard {
+			_ = s.files.TrashBoardFiles(ctx, item.BoardID, itemID)
+		} else {
+			_ = s.files.TrashCardFiles(ctx, domain.CardRef{Board: item.BoardID, ID: item.CardID}, itemID)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Whatever else the item's directory held, as when it was copied from
+	// the yaml store, goes with the restored item.
+	_ = s.files.PurgeTrashFiles(ctx, itemID)
+	return item, nil
+}
+
+// PurgeTrash deletes a trashed item and the files kept beside its cards.
+func (s *Store) PurgeTrash(ctx context.Context, itemID string) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, "DELETE FROM trash WHERE id = ?", itemID)
+		if err != nil {
+			return fmt.Errorf("purge trash item: %w", err)
+		}
+		if n, err := res.RowsAffected(); err == nil && n == 0 {
+			return &domain.ErrNotFound{Resource: "trash item", ID: itemID}
+		}
+		return s.files.PurgeTrashFiles(ctx, itemID)
+	})
+}
+
+func restoreBoard(ctx context.Context, tx *sql.Tx, item *domain.TrashItem, data string) error {
+	if _, err := getBoard(ctx, tx, item.BoardID); err == nil {
+		return &domain.ErrConflict{Resource: "board", ID: item.BoardID}
+	}
+	var trashed trashedBoard
+	if err := json.Unmarshal([]byte(data), &trashed); err != nil {
+		return fmt.Errorf("unmarshal trashed board: %w", err)
+	}
+	if err := saveBoard(ctx, tx, trashed.Board); err != nil {
+		return err
+	}
+	for i := range trashed.Cards {
+		if err := saveCard(ctx, tx, item.BoardID, &trashed.Cards[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreCard puts the card back, under a new ID if its own was reused.
+func restoreCard(ctx context.Context, tx *sql.Tx, item *domain.TrashItem, data string) error {
+	if _, err := getBoard(ctx, tx, item.BoardID); err != nil {
+		return err
+	}
+	card, err := unmarshalCard(data)
+	if err != nil {
+		return err
+	}
+	card.ID = item.CardID
+	if _, err := getCard(ctx, tx, item.BoardID, card.ID); err == nil {
+		id, err := nextID(ctx, tx, item.BoardID)
+		if err != nil {
+			return err
+		}
+		card.ID = id
+	}
+	if err := saveCard(ctx, tx, item.BoardID, card); err != nil {
+		return err
+	}
+	item.CardID = card.ID
+	return nil
+}
+
+func getTrashItem(ctx context.Context, q querier, itemID string) (*domain.TrashItem, string, error) {
+	row := q.QueryRowContext(ctx,
+		"SELECT id, kind, board_id, card_id, name, deleted_at, data FROM trash WHERE id = ?", itemID)
+	var item domain.TrashItem
+	var deletedAt, data string
+	err := row.Scan(&item.ID, &item.Kind, &item.BoardID, &item.CardID, &item.Name, &deletedAt, &data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", &domain.ErrNotFound{Resource: "trash item", ID: itemID}
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("get trash item: %w", err)
+	}
+	if item.DeletedAt, err = time.Parse(timeLayout, deletedAt); err != nil {
+		return nil, "", fmt.Errorf("parse deleted_at: %w", err)
+	}
+	return &item, data, nil
+}
+
+func scanTrashItem(rows *sql.Rows) (*domain.TrashItem, error) {
+	var item domain.TrashItem
+	var deletedAt string
+	if err := rows.Scan(&item.ID, &item.Kind, &item.BoardID, &item.CardID, &item.Name, &deletedAt); err != nil {
+		return nil, fmt.Errorf("scan trash item: %w", err)
+	}
+	var err error
+	if item.DeletedAt, err = time.Parse(timeLayout, deletedAt); err != nil {
+		return nil, fmt.Errorf("parse deleted_at: %w", err)
+	}
+	return &item, nil
+}
+
+// createTrashItem stores item with the deleted data. Its ID starts with the
+// deletion time, as the yaml store's trash IDs do.
+func createTrashItem(ctx context.Context, q querier, item *domain.TrashItem, deleted any) error {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Errorf("generate trash id: %w", err)
+	}
+	item.ID = item.DeletedAt.UTC().Format("20060102-150405") + "-" + hex.EncodeToString(b)
+	return insertTrashItem(ctx, q, item, deleted)
+}
+
+func insertTrashItem(ctx context.Context, q querier, item *domain.TrashItem, deleted any) error {
+	data, err := json.Marshal(deleted)
+	if err != nil {
+		return fmt.Errorf("marshal trash item: %w", err)
+	}
+	_, err = q.ExecContext(ctx,
+		"INSERT INTO trash (id, kind, board_id, card_id, name, deleted_at, data) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		item.ID, string(item.Kind), item.BoardID, item.CardID, item.Name,
+		item.DeletedAt.UTC().Format(timeLayout), string(data))
+	if err != nil {
+		return fmt.Errorf("create trash item: %w", err)
+	}
+	return nil
+}
+
+// TrashCopier implementation
+
+// ExportTrash returns every trashed item with the board and cards it holds.
+func (s *Store) ExportTrash(ctx context.Context) ([]domain.TrashEntry, error) {
+	items, err := s.ListTrash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]domain.TrashEntry, 0, len(items))
+	for _, listed := range items {
+		item, data, err := getTrashItem(ctx, s.db, listed.ID)
+		if err != nil {
+			return nil, err
+		}
+		entry := domain.TrashEntry{Item: *item}
+		switch item.Kind {
+		case domain.TrashBoard:
+			var trashed trashedBoard
+			if err := json.Unmarshal([]byte(data), &trashed); err != nil {
+				return nil, fmt.Errorf("unmarshal trashed board: %w", err)
+			}
+			entry.Board, entry.Cards = trashed.Board, trashed.Cards
+		case domain.TrashCard:
+			card, err := unmarshalCard(data)
+			if err != nil {
+				return nil, err
+			}
+			card.ID = item.CardID
+			entry.Cards = []domain.Card{*card}
+		default:
+			return nil, fmt.Errorf("unknown trash item kind %q", item.Kind)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// ImportTrash adds entries to the trash in one transaction.
+func (s *Store) ImportTrash(ctx context.Context, entries []domain.TrashEntry) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		for i := range entries {
+			entry := &entries[i]
+			if err := domain.ValidateTrashItemID(entry.Item.ID); err != nil {
+				return err
+			}
+			if _, _, err := getTrashItem(ctx, tx, entry.Item.ID); err == nil {
+				return &domain.ErrConflict{Resource: "trash item", ID: entry.Item.ID}
+			}
+
+			var deleted any
+			switch entry.Item.Kind {
+			case domain.TrashBoard:
+				if entry.Board == nil {
+					return fmt.Errorf("trash item %s: trashed board without its board", entry.Item.ID)
+				}
+				deleted = trashedBoard{Board: entry.Board, Cards: entry.Cards}
+			case domain.TrashCard:
+				if len(entry.Cards) != 1 {
+					return fmt.Errorf("trash item %s: trashed card with %d cards", entry.Item.ID, len(entry.Cards))
+				}
+				deleted = &entry.Cards[0]
+			default:
+				return fmt.Errorf("trash item %s: unknown kind %q", entry.Item.ID, entry.Item.Kind)
+			}
+			if err := insertTrashItem(ctx, tx, &entry.Item, deleted); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// noCardFiles is the CardFileRepository of a store without one: there are no
+// files to move.
+type noCardFiles struct{}
+
+func (noCardFiles) MoveCardFiles(context.Context, domain.CardRef, domain.CardRef) error { return nil }
+func (noCardFiles) TrashCardFiles(context.Context, domain.CardRef, string) error        { return nil }
+func (noCardFiles) RestoreCardFiles(context.Context, string, domain.CardRef) error      { return nil }
+func (noCardFiles) TrashBoardFiles(context.Context, string, string) error               { return nil }
+func (noCardFiles) RestoreBoardFiles(context.Context, string, string) error             { return nil }
+func (noCardFiles) PurgeTrashFiles(context.Context, string) error                       { return nil }
@@ -0,0 +1,53 @@
+package watcher
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+)
+
+// Notifier broadcasts the events the watcher raises for changed files, for
+// writes the watcher cannot see, such as those to a SQLite database. Events
+// are sent at once, without the watcher's debouncing.
+type Notifier struct {
+	broadcaster Broadcaster
+	listeners   []Listener
+}
+
+func NewNotifier(broadcaster Broadcaster) *Notifier {
+	return &Notifier{broadcaster: broadcaster}
+}
+
+// AddListener registers l for the reported changes, as Watcher.AddListener
+// does for changed files. It must be called before the first change.
+func (n *Notifier) AddListener(l Listener) {
+	n.listeners = append(n.listeners, l)
+}
+
+func (n *Notifier) BoardChanged(boardID string) {
+	n.notify(boardID, "")
+	n.send(&event{Type: "board_updated", BoardID: boardID, Time: time.Now().Format(time.RFC3339)})
+}
+
+func (n *Notifier) CardChanged(boardID, cardID string) {
+	n.notify(boardID, cardID)
+	n.send(&event{Type: "card_updated", BoardID: boardID, CardID: cardID, Time: time.Now().Format(time.RFC3339)})
+}
+
+func (n *Notifier) notify(boardID, cardID string) {
+	for _, l := range n.listeners {
+		if err := l.Changed(context.Background(), boardID, cardID); err != nil {
+			slog.Error("notifier: listener failed", "board_id", boardID, "card_id", cardID, "error", err)
+		}
+	}
+}
+
+func (n *Notifier) send(ev *event) {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		slog.Error("failed to marshal notifier event", "error", err)
+		return
+	}
+	n.broadcaster.BroadcastRaw(data)
+}
@@ -15,6 +15,7 @@ import (
 type event struct {
 	Type    string `json:"type"`
 	BoardID string `json:"board_id"`
+	CardID  string `json:"card_id,omitempty"`
 	Time    string `json:"timestamp"`
 }
 
@@ -22,10 +23,22 @@ type Broadcaster interface {
 	BroadcastRaw(data []byte)
 }
 
+// Listener is told about changed cards once each debounce window closes.
+// An empty cardID means a board-level file changed.
+type Listener interface {
+	Changed(ctx context.Context, boardID, cardID string) error
+}
+
+type change struct {
+	boardID string
+	cardID  string
+}
+
 type Watcher struct {
 	broadcaster Broadcaster
 	basePath    string
 	debounce    time.Duration
+	listeners   []Listener
 }
 
 func New(broadcaster Broadcaster, basePath string) *Watcher {
@@ -36,6 +49,12 @@ func New(broadcaster Broadcaster, basePath string) *Watcher {
 	}
 }
 
+// AddListener registers l for changes to board and card files. It must be
+// called before Start.
+func (w *Watcher) AddListener(l Listener) {
+	w.listeners = append(w.listeners, l)
+}
+
 func (w *Watcher) Start(ctx context.Context) error {
 	fsw, err := fsnotify.NewWatcher()
 	if err != nil {
@@ -53,7 +72,10 @@ func (w *Watcher) Start(ctx context.Context) error {
 		<-timer.C
 	}
 
-	var pendingEvent *event
+	// The last event per board is kept so that a change touching two boards,
+	// such as a cross-board card move, notifies both.
+	pendingEvents := make(map[string]*event)
+	pendingChanges := make(map[change]struct{})
 
 	for {
 		select {
@@ -69,18 +91,22 @@ func (w *Watcher) Start(ctx context.Context) error {
 				continue
 			}
 
-			if !strings.HasSuffix(fsEvent.Name, ".yaml") {
+			var ev *event
+			if isDataFile(fsEvent.Name) {
+				ev = w.classifyEvent(fsEvent.Name)
+			} else {
 				if fsEvent.Op&fsnotify.Create != 0 {
 					_ = w.addRecursive(fsw, fsEvent.Name)
 				}
-				continue
+				ev = w.classifyBoardDir(fsEvent.Name)
 			}
-
-			ev := w.classifyEvent(fsEvent.Name)
 			if ev == nil {
 				continue
 			}
-			pendingEvent = ev
+			pendingEvents[ev.BoardID] = ev
+			if ev.Type == "board_updated" || ev.Type == "card_updated" {
+				pendingChanges[change{boardID: ev.BoardID, cardID: ev.CardID}] = struct{}{}
+			}
 
 			if !timer.Stop() {
 				select {
@@ -91,14 +117,18 @@ func (w *Watcher) Start(ctx context.Context) error {
 			timer.Reset(w.debounce)
 
 		case <-timer.C:
-			if pendingEvent != nil {
-				data, err := json.Marshal(pendingEvent)
+			for boardID, ev := range pendingEvents {
+				data, err := json.Marshal(ev)
 				if err != nil {
 					slog.Error("failed to marshal watcher event", "error", err)
 				} else {
 					w.broadcaster.BroadcastRaw(data)
 				}
-				pendingEvent = nil
+				delete(pendingEvents, boardID)
+			}
+			for c := range pendingChanges {
+				w.notify(ctx, c)
+				delete(pendingChanges, c)
 			}
 
 		case err, ok := <-fsw.Errors:
@@ -123,18 +153,69 @@ func (w *Watcher) classifyEvent(path string) *event {
 
 	boardID := parts[1]
 	eventType := "board_updated"
-
-	if len(parts) >= 4 && parts[2] == "cards" {
-		eventType = "card_updated"
+	cardID := ""
+
+	if len(parts) >= 4 {
+		switch parts[2] {
+		case "cards":
+			eventType = "card_updated"
+			cardID = strings.TrimSuffix(parts[3], filepath.Ext(parts[3]))
+		case "comments":
+			eventType = "comment_updated"
+			cardID = strings.TrimSuffix(parts[3], ".yaml")
+		}
 	}
 
 	return &event{
 		Type:    eventType,
 		BoardID: boardID,
+		CardID:  cardID,
 		Time:    time.Now().Format(time.RFC3339),
 	}
 }
 
+// isDataFile reports whether path is a file the store reads: any YAML file,
+// or a Markdown card in a cards directory. Other files, such as attachments
+// or the store's temporary files, raise no events.
+func isDataFile(path string) bool {
+	switch filepath.Ext(path) {
+	case ".yaml":
+		return true
+	case ".md":
+		return filepath.Base(filepath.Dir(path)) == "cards"
+	}
+	return false
+}
+
+// classifyBoardDir reports a board directory that appeared or disappeared as a
+// whole, such as a board moved to or restored from the trash. No events are
+// raised for the files inside it.
+func (w *Watcher) classifyBoardDir(path string) *event {
+	rel, err := filepath.Rel(w.basePath, path)
+	if err != nil {
+		return nil
+	}
+
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	if len(parts) != 2 || parts[0] != "boards" {
+		return nil
+	}
+
+	return &event{
+		Type:    "board_updated",
+		BoardID: parts[1],
+		Time:    time.Now().Format(time.RFC3339),
+	}
+}
+
+func (w *Watcher) notify(ctx context.Context, c change) {
+	for _, l := range w.listeners {
+		if err := l.Changed(ctx, c.boardID, c.cardID); err != nil {
+			slog.Error("watcher: listener failed", "board_id", c.boardID, "card_id", c.cardID, "error", err)
+		}
+	}
+}
+
 func (w *Watcher) addRecursive(fsw *fsnotify.Watcher, path string) error {
 	return filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
 		if err != nil {
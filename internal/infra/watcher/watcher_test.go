@@ -133,6 +133,100 @@ func TestWatcher_Start_CardUpdated(t *testing.T) {
 	}
 }
 
+func TestWatcher_Start_MarkdownCardUpdated(t *testing.T) {
+	tmpDir := t.TempDir()
+	cardsDir := filepath.Join(tmpDir, "boards", "test-board", "cards")
+	if err := os.MkdirAll(cardsDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	bc := &mockBroadcaster{}
+	w := watcher.New(bc, tmpDir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- w.Start(ctx)
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+
+	if err := os.WriteFile(filepath.Join(cardsDir, "20260124-001.md"), []byte("---\ntitle: Test Card\n---\nBody"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	time.Sleep(1 * time.Second)
+
+	msgs := bc.getMessages()
+	if len(msgs) == 0 {
+		t.Fatal("expected at least one broadcast message")
+	}
+
+	var ev struct {
+		Type    string `json:"type"`
+		BoardID string `json:"board_id"`
+		CardID  string `json:"card_id"`
+	}
+	if err := json.Unmarshal(msgs[0], &ev); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if ev.Type != "card_updated" {
+		t.Errorf("type = %s, want card_updated", ev.Type)
+	}
+	if ev.CardID != "20260124-001" {
+		t.Errorf("card_id = %s, want 20260124-001", ev.CardID)
+	}
+}
+
+func TestWatcher_Start_CommentUpdated(t *testing.T) {
+	tmpDir := t.TempDir()
+	commentsDir := filepath.Join(tmpDir, "boards", "test-board", "comments")
+	if err := os.MkdirAll(commentsDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	bc := &mockBroadcaster{}
+	w := watcher.New(bc, tmpDir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- w.Start(ctx)
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+
+	if err := os.WriteFile(filepath.Join(commentsDir, "20260124-001.yaml"), []byte("- id: \"1\""), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	time.Sleep(1 * time.Second)
+
+	msgs := bc.getMessages()
+	if len(msgs) == 0 {
+		t.Fatal("expected at least one broadcast message")
+	}
+
+	var ev struct {
+		Type    string `json:"type"`
+		BoardID string `json:"board_id"`
+		CardID  string `json:"card_id"`
+	}
+	if err := json.Unmarshal(msgs[0], &ev); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if ev.Type != "comment_updated" {
+		t.Errorf("type = %s, want comment_updated", ev.Type)
+	}
+	if ev.CardID != "20260124-001" {
+		t.Errorf("card_id = %s, want 20260124-001", ev.CardID)
+	}
+}
+
 func TestWatcher_Start_NonYamlIgnored(t *testing.T) {
 	tmpDir := t.TempDir()
 	boardsDir := filepath.Join(tmpDir, "boards", "test-board")
@@ -153,10 +247,13 @@ func TestWatcher_Start_NonYamlIgnored(t *testing.T) {
 
 	time.Sleep(200 * time.Millisecond)
 
-	// Create a non-yaml file
+	// Create a non-yaml file, and a Markdown file outside the cards dir
 	if err := os.WriteFile(filepath.Join(boardsDir, "notes.txt"), []byte("hello"), 0o644); err != nil {
 		t.Fatalf("write: %v", err)
 	}
+	if err := os.WriteFile(filepath.Join(boardsDir, "README.md"), []byte("# hello"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
 
 	time.Sleep(800 * time.Millisecond)
 
@@ -173,3 +270,177 @@ func TestWatcher_New(t *testing.T) {
 		t.Fatal("expected non-nil watcher")
 	}
 }
+
+type mockListener struct {
+	mu      sync.Mutex
+	changes []string
+}
+
+func (m *mockListener) Changed(_ context.Context, boardID, cardID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.changes = append(m.changes, boardID+"/"+cardID)
+	return nil
+}
+
+func (m *mockListener) getChanges() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cp := make([]string, len(m.changes))
+	copy(cp, m.changes)
+	return cp
+}
+
+func TestWatcher_Start_NotifiesListeners(t *testing.T) {
+	tmpDir := t.TempDir()
+	cardsDir := filepath.Join(tmpDir, "boards", "test-board", "cards")
+	if err := os.MkdirAll(cardsDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	l := &mockListener{}
+	w := watcher.New(&mockBroadcaster{}, tmpDir)
+	w.AddListener(l)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		_ = w.Start(ctx)
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+
+	if err := os.WriteFile(filepath.Join(cardsDir, "20260124-001.yaml"), []byte("title: Test Card"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	time.Sleep(1 * time.Second)
+
+	changes := l.getChanges()
+	if len(changes) != 1 || changes[0] != "test-board/20260124-001" {
+		t.Errorf("changes = %v, want [test-board/20260124-001]", changes)
+	}
+}
+
+func TestWatcher_Start_EventPerBoard(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, board := range []string{"board-a", "board-b"} {
+		if err := os.MkdirAll(filepath.Join(tmpDir, "boards", board, "cards"), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+
+	bc := &mockBroadcaster{}
+	w := watcher.New(bc, tmpDir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		_ = w.Start(ctx)
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+
+	// A card moving between boards touches both within one debounce window.
+	for _, board := range []string{"board-a", "board-b"} {
+		if err := os.WriteFile(filepath.Join(tmpDir, "boards", board, "cards", "20260124-001.yaml"), []byte("title: Test"), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	time.Sleep(1 * time.Second)
+
+	boards := map[string]bool{}
+	for _, msg := range bc.getMessages() {
+		var ev struct {
+			BoardID string `json:"board_id"`
+		}
+		if err := json.Unmarshal(msg, &ev); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		boards[ev.BoardID] = true
+	}
+	if !boards["board-a"] || !boards["board-b"] {
+		t.Errorf("events for boards %v, want board-a and board-b", boards)
+	}
+}
+
+func TestWatcher_Start_BoardDirMoved(t *testing.T) {
+	tmpDir := t.TempDir()
+	boardDir := filepath.Join(tmpDir, "boards", "test-board")
+	if err := os.MkdirAll(filepath.Join(boardDir, "cards"), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	trashDir := filepath.Join(tmpDir, "trash", "item")
+	if err := os.MkdirAll(trashDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+
+	l := &mockListener{}
+	w := watcher.New(&mockBroadcaster{}, tmpDir)
+	w.AddListener(l)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		_ = w.Start(ctx)
+	}()
+
+	time.Sleep(200 * time.Millisecond)
+
+	// Moving a board to the trash renames its directory without touching the files.
+	if err := os.Rename(boardDir, filepath.Join(trashDir, "board")); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+
+	time.Sleep(1 * time.Second)
+
+	changes := l.getChanges()
+	if len(changes) != 1 || changes[0] != "test-board/" {
+		t.Errorf("changes = %v, want [test-board/]", changes)
+	}
+}
+
+func TestNotifier(t *testing.T) {
+	bc := &mockBroadcaster{}
+	n := watcher.NewNotifier(bc)
+
+	n.BoardChanged("board-1")
+	n.CardChanged("board-1", "card-1")
+
+	msgs := bc.getMessages()
+	if len(msgs) != 2 {
+		t.Fatalf("got %d messages, want 2", len(msgs))
+	}
+	var ev map[string]string
+	if err := json.Unmarshal(msgs[0], &ev); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if ev["type"] != "board_updated" || ev["board_id"] != "board-1" || ev["card_id"] != "" {
+		t.Errorf("board event = %v", ev)
+	}
+	ev = nil
+	if err := json.Unmarshal(msgs[1], &ev); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if ev["type"] != "card_updated" || ev["board_id"] != "board-1" || ev["card_id"] != "card-1" || ev["timestamp"] == "" {
+		t.Errorf("card event = %v", ev)
+	}
+}
+
+func TestNotifier_NotifiesListeners(t *testing.T) {
+	l := &mockListener{}
+	n := watcher.NewNotifier(&mockBroadcaster{})
+	n.AddListener(l)
+
+	n.BoardChanged("board-1")
+	n.CardChanged("board-1", "card-1")
+
+	changes := l.getChanges()
+	if len(changes) != 2 || changes[0] != "board-1/" || changes[1] != "board-1/card-1" {
+		t.Errorf("changes = %v, want [board-1/ board-1/card-1]", changes)
+	}
+}
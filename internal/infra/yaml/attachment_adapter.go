@@ -0,0 +1,27 @@
+package yaml
+
+import (
+	"context"
+	"io"
+)
+
+// AttachmentRepositoryAdapter adapts Store to satisfy domain.AttachmentRepository interface.
+type AttachmentRepositoryAdapter struct {
+	store *Store
+}
+
+func NewAttachmentRepositoryAdapter(store *Store) *AttachmentRepositoryAdapter {
+	return &AttachmentRepositoryAdapter{store: store}
+}
+
+func (a *AttachmentRepositoryAdapter) Put(ctx context.Context, boardID, cardID, attachmentID string, r io.Reader) (int64, error) {
+	return a.store.PutAttachment(ctx, boardID, cardID, attachmentID, r)
+}
+
+func (a *AttachmentRepositoryAdapter) Open(ctx context.Context, boardID, cardID, attachmentID string) (io.ReadCloser, error) {
+	return a.store.OpenAttachment(ctx, boardID, cardID, attachmentID)
+}
+
+func (a *AttachmentRepositoryAdapter) Delete(ctx context.Context, boardID, cardID, attachmentID string) error {
+	return a.store.DeleteAttachment(ctx, boardID, cardID, attachmentID)
+}
@@ -0,0 +1,74 @@
+package yaml
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func (s *Store) attachmentsDir(boardID, cardID string) string {
+	return filepath.Join(s.boardDir(boardID), "attachments", cardID)
+}
+
+func (s *Store) attachmentFile(boardID, cardID, attachmentID string) string {
+	return filepath.Join(s.attachmentsDir(boardID, cardID), attachmentID)
+}
+
+// AttachmentRepository implementation
+//
+// Attachment contents are stored as plain files and are not guarded by s.mu:
+// each attachment ID is written once and never modified in place.
+
+// PutAttachment writes r to the attachment file through a temporary file so a
+// failed upload never leaves a partial attachment behind.
+func (s *Store) PutAttachment(_ context.Context, boardID, cardID, attachmentID string, r io.Reader) (int64, error) {
+	dir := s.attachmentsDir(boardID, cardID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, fmt.Errorf("create attachments dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return 0, fmt.Errorf("create attachment file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	n, err := io.Copy(tmp, r)
+	if err != nil {
+		_ = tmp.Close()
+		return n, fmt.Errorf("write attachment file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return n, fmt.Errorf("close attachment file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.attachmentFile(boardID, cardID, attachmentID)); err != nil {
+		return n, fmt.Errorf("rename attachment file: %w", err)
+	}
+	return n, nil
+}
+
+func (s *Store) OpenAttachment(_ context.Context, boardID, cardID, attachmentID string) (io.ReadCloser, error) {
+	f, err := os.Open(s.attachmentFile(boardID, cardID, attachmentID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, &domain.ErrNotFound{Resource: "attachment", ID: attachmentID}
+		}
+		return nil, fmt.Errorf("open attachment file: %w", err)
+	}
+	return f, nil
+}
+
+func (s *Store) DeleteAttachment(_ context.Context, boardID, cardID, attachmentID string) error {
+	if err := os.Remove(s.attachmentFile(boardID, cardID, attachmentID)); err != nil {
+		if os.IsNotExist(err) {
+			return &domain.ErrNotFound{Resource: "attachment", ID: attachmentID}
+		}
+		return fmt.Errorf("remove attachment file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package yaml
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// stagedCard is a card file written beside its final path, waiting to be
+// renamed into place.
+type stagedCard struct {
+	path   string
+	tmp    string
+	backup string
+}
+
+// SaveCards writes and deletes a set of cards all or nothing. Every card is
+// first written to a temporary file; only when all of them are on disk are
+// the deleted cards moved to the trash and the written ones renamed over the
+// live files, whose old contents are kept aside until the last rename
+// succeeds. A failure at any point puts the old files back.
+func (s *Store) SaveCards(_ context.Context, writes []domain.CardWrite) error {
+	boardIDs := make([]string, len(writes))
+	for i, w := range writes {
+		boardIDs[i] = w.BoardID
+	}
+	defer s.lockBoards(boardIDs...)()
+
+	// A card written twice keeps its last write.
+	last := make(map[string]int, len(writes))
+	for i, w := range writes {
+		last[s.cardFile(w.BoardID, w.Card.ID)] = i
+	}
+
+	staged := make([]stagedCard, 0, len(last))
+	var deletes []domain.CardWrite
+	discard := func() {
+		for _, st := range staged {
+			_ = os.Remove(st.tmp)
+		}
+	}
+	for i, w := range writes {
+		path := s.cardFile(w.BoardID, w.Card.ID)
+		if last[path] != i {
+			continue
+		}
+		if w.Delete {
+			if _, err := os.Stat(path); os.IsNotExist(err) {
+				discard()
+				return &domain.ErrNotFound{Resource: "card", ID: w.Card.ID}
+			}
+			deletes = append(deletes, w)
+			continue
+		}
+		if err := os.MkdirAll(s.cardsDir(w.BoardID), 0o755); err != nil {
+			discard()
+			return fmt.Errorf("create cards dir: %w", err)
+		}
+		data, err := encodeCard(path, w.Card)
+		if err != nil {
+			discard()
+			return err
+		}
+		st := stagedCard{path: path, tmp: path + ".tmp"}
+		if err := os.WriteFile(st.tmp, data, 0o644); err != nil {
+			_ = os.Remove(st.tmp)
+			discard()
+			return fmt.Errorf("stage card file: %w", err)
+		}
+		staged = append(staged, st)
+	}
+
+	trashDirs := make([]string, 0, len(deletes))
+	untrash := func() {
+		for i := len(trashDirs) - 1; i >= 0; i-- {
+			s.untrashCardLocked(deletes[i].BoardID, deletes[i].Card.ID, trashDirs[i])
+		}
+	}
+	for _, w := range deletes {
+		dir, err := s.trashCardLocked(w.BoardID, w.Card.ID)
+		if err != nil {
+			untrash()
+			discard()
+			return err
+		}
+		trashDirs = append(trashDirs, dir)
+	}
+
+	for i := range staged {
+		if err := s.commitStaged(&staged[i]); err != nil {
+			s.rollbackStaged(staged[:i])
+			untrash()
+			discard()
+			return err
+		}
+	}
+	for _, st := range staged {
+		if st.backup != "" {
+			_ = os.Remove(st.backup)
+		}
+	}
+	return nil
+}
+
+// commitStaged moves the live file aside and the staged file into its place.
+// On failure the live file is left as it was.
+func (s *Store) commitStaged(st *stagedCard) error {
+	s.cards.remove(st.path)
+	if _, err := os.Stat(st.path); err == nil {
+		backup := st.path + ".bak"
+		if err := os.Rename(st.path, backup); err != nil {
+			return fmt.Errorf("back up card file: %w", err)
+		}
+		st.backup = backup
+	}
+	if err := os.Rename(st.tmp, st.path); err != nil {
+		if st.backup != "" {
+			_ = os.Rename(st.backup, st.path)
+			st.backup = ""
+		}
+		return fmt.Errorf("commit card file: %w", err)
+	}
+	return nil
+}
+
+// rollbackStaged undoes committed files in reverse order, restoring the old
+// file where there was one and removing the new one where there was not.
+func (s *Store) rollbackStaged(committed []stagedCard) {
+	for i := len(committed) - 1; i >= 0; i-- {
+		st := committed[i]
+		s.cards.remove(st.path)
+		if st.backup != "" {
+			_ = os.Rename(st.backup, st.path)
+		} else {
+			_ = os.Remove(st.path)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package yaml
+
+import (
+	"context"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// BoardTemplateRepositoryAdapter adapts Store to satisfy domain.BoardTemplateRepository interface.
+type BoardTemplateRepositoryAdapter struct {
+	store *Store
+}
+
+func NewBoardTemplateRepositoryAdapter(store *Store) *BoardTemplateRepositoryAdapter {
+	return &BoardTemplateRepositoryAdapter{store: store}
+}
+
+func (a *BoardTemplateRepositoryAdapter) List(ctx context.Context) ([]domain.BoardTemplate, error) {
+	return a.store.ListBoardTemplates(ctx)
+}
+
+func (a *BoardTemplateRepositoryAdapter) Get(ctx context.Context, templateID string) (*domain.BoardTemplate, error) {
+	return a.store.GetBoardTemplate(ctx, templateID)
+}
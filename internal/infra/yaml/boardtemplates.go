@@ -0,0 +1,88 @@
+package yaml
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func (s *Store) boardTemplatesDir() string {
+	return filepath.Join(s.basePath, "board-templates")
+}
+
+func (s *Store) boardTemplateFile(templateID string) string {
+	return filepath.Join(s.boardTemplatesDir(), templateID+".yaml")
+}
+
+// BoardTemplateRepository implementation
+
+func (s *Store) ListBoardTemplates(_ context.Context) ([]domain.BoardTemplate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries, err := os.ReadDir(s.boardTemplatesDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []domain.BoardTemplate{}, nil
+		}
+		return nil, fmt.Errorf("read board templates dir: %w", err)
+	}
+
+	templates := []domain.BoardTemplate{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+		id := strings.TrimSuffix(entry.Name(), ".yaml")
+		if domain.ValidateTemplateID(id) != nil {
+			continue
+		}
+		t, err := s.readBoardTemplate(id)
+		if err != nil {
+			continue
+		}
+		templates = append(templates, *t)
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].ID < templates[j].ID
+	})
+	return templates, nil
+}
+
+func (s *Store) GetBoardTemplate(_ context.Context, templateID string) (*domain.BoardTemplate, error) {
+	if err := domain.ValidateTemplateID(templateID); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.readBoardTemplate(templateID)
+}
+
+func (s *Store) readBoardTemplate(templateID string) (*domain.BoardTemplate, error) {
+	data, err := os.ReadFile(s.boardTemplateFile(templateID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, &domain.ErrNotFound{Resource: "board template", ID: templateID}
+		}
+		return nil, fmt.Errorf("read board template file: %w", err)
+	}
+
+	var t domain.BoardTemplate
+	if err := yamlv3.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("unmarshal board template: %w", err)
+	}
+	t.ID = templateID
+	if t.Name == "" {
+		t.Name = templateID
+	}
+	return &t, nil
+}
@@ -0,0 +1,69 @@
+package yaml
+
+import (
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// cardCache holds parsed card files keyed by path. An entry is only used while
+// the file's size and modification time are unchanged. Writes through the
+// Store and changes reported by the watcher drop entries as well, since a
+// rewrite within one timestamp tick can leave both unchanged.
+type cardCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedCard
+}
+
+type cachedCard struct {
+	modTime time.Time
+	size    int64
+	card    *domain.Card
+}
+
+func newCardCache() *cardCache {
+	return &cardCache{entries: make(map[string]cachedCard)}
+}
+
+// get returns a copy of the cached card for path if info still matches it.
+func (c *cardCache) get(path string, info fs.FileInfo) (*domain.Card, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[path]
+	if !ok || e.size != info.Size() || !e.modTime.Equal(info.ModTime()) {
+		return nil, false
+	}
+	return e.card.Clone(), true
+}
+
+func (c *cardCache) put(path string, info fs.FileInfo, card *domain.Card) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[path] = cachedCard{modTime: info.ModTime(), size: info.Size(), card: card.Clone()}
+}
+
+func (c *cardCache) remove(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, path)
+}
+
+// removeDir drops every entry under dir.
+func (c *cardCache) removeDir(dir string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prefix := dir + string(filepath.Separator)
+	for path := range c.entries {
+		if strings.HasPrefix(path, prefix) {
+			delete(c.entries, path)
+		}
+	}
+}
@@ -27,6 +27,10 @@ func (a *CardRepositoryAdapter) Save(ctx context.Context, boardID string, card *
 	return a.store.SaveCard(ctx, boardID, card)
 }
 
+func (a *CardRepositoryAdapter) SaveAll(ctx context.Context, writes []domain.CardWrite) error {
+	return a.store.SaveCards(ctx, writes)
+}
+
 func (a *CardRepositoryAdapter) Delete(ctx context.Context, boardID, cardID string) error {
 	return a.store.DeleteCard(ctx, boardID, cardID)
 }
@@ -38,3 +42,7 @@ func (a *CardRepositoryAdapter) NextID(ctx context.Context, boardID string) (str
 func (a *CardRepositoryAdapter) Create(ctx context.Context, boardID string, card *domain.Card) (string, error) {
 	return a.store.CreateCard(ctx, boardID, card)
 }
+
+func (a *CardRepositoryAdapter) Transfer(ctx context.Context, fromBoardID, toBoardID string, card *domain.Card) (string, error) {
+	return a.store.TransferCard(ctx, fromBoardID, toBoardID, card)
+}
@@ -0,0 +1,148 @@
+package yaml
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// The files kept beside a card are its comments file and attachments
+// directory. In a trash item they are comments.yaml and attachments/, and a
+// board's directory is board/. The store moves them itself along with its own
+// cards; the CardFileRepository methods move them for a storage that keeps the
+// cards elsewhere.
+
+// CardFileRepository implementation
+
+func (s *Store) MoveCardFiles(_ context.Context, from, to domain.CardRef) error {
+	defer s.lockBoards(from.Board, to.Board)()
+
+	return s.moveCardFilesLocked(from, to)
+}
+
+func (s *Store) TrashCardFiles(_ context.Context, card domain.CardRef, itemID string) error {
+	dir, err := s.checkedTrashItemDir(itemID)
+	if err != nil {
+		return err
+	}
+
+	defer s.lockBoard(card.Board)()
+	s.trashMu.Lock()
+	defer s.trashMu.Unlock()
+
+	return s.trashCardFilesLocked(card, dir)
+}
+
+func (s *Store) RestoreCardFiles(_ context.Context, itemID string, card domain.CardRef) error {
+	dir, err := s.checkedTrashItemDir(itemID)
+	if err != nil {
+		return err
+	}
+
+	defer s.lockBoard(card.Board)()
+	s.trashMu.Lock()
+	defer s.trashMu.Unlock()
+
+	return s.restoreCardFilesLocked(dir, card)
+}
+
+func (s *Store) TrashBoardFiles(_ context.Context, boardID, itemID string) error {
+	dir, err := s.checkedTrashItemDir(itemID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := moveIfExists(s.boardDir(boardID), filepath.Join(dir, "board")); err != nil {
+		return fmt.Errorf("move board dir to trash: %w", err)
+	}
+	s.cards.removeDir(s.boardDir(boardID))
+	return nil
+}
+
+// RestoreBoardFiles does not restore over a board directory that exists.
+func (s *Store) RestoreBoardFiles(_ context.Context, itemID, boardID string) error {
+	dir, err := s.checkedTrashItemDir(itemID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trashed := filepath.Join(dir, "board")
+	if _, err := os.Stat(trashed); os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := os.Stat(s.boardDir(boardID)); err == nil {
+		return &domain.ErrConflict{Resource: "board", ID: boardID}
+	}
+	if err := os.Rename(trashed, s.boardDir(boardID)); err != nil {
+		return fmt.Errorf("restore board dir: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) PurgeTrashFiles(_ context.Context, itemID string) error {
+	dir, err := s.checkedTrashItemDir(itemID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.trashMu.Lock()
+	defer s.trashMu.Unlock()
+
+	return os.RemoveAll(dir)
+}
+
+// moveCardFilesLocked moves the files of from to to while the caller holds
+// both boards' locks. If the attachments cannot be moved, the comments are
+// moved back.
+func (s *Store) moveCardFilesLocked(from, to domain.CardRef) error {
+	return moveFilePair(
+		s.commentsFile(from.Board, from.ID), s.commentsFile(to.Board, to.ID),
+		s.attachmentsDir(from.Board, from.ID), s.attachmentsDir(to.Board, to.ID),
+	)
+}
+
+// trashCardFilesLocked moves the files of card into the trash item directory
+// dir while the caller holds the board's lock and s.trashMu, or s.mu for
+// writing.
+func (s *Store) trashCardFilesLocked(card domain.CardRef, dir string) error {
+	return moveFilePair(
+		s.commentsFile(card.Board, card.ID), filepath.Join(dir, "comments.yaml"),
+		s.attachmentsDir(card.Board, card.ID), filepath.Join(dir, "attachments"),
+	)
+}
+
+// restoreCardFilesLocked is the reverse of trashCardFilesLocked.
+func (s *Store) restoreCardFilesLocked(dir string, card domain.CardRef) error {
+	return moveFilePair(
+		filepath.Join(dir, "comments.yaml"), s.commentsFile(card.Board, card.ID),
+		filepath.Join(dir, "attachments"), s.attachmentsDir(card.Board, card.ID),
+	)
+}
+
+// moveFilePair moves a card's comments file and attachments directory, both
+// or neither.
+func moveFilePair(commentsSrc, commentsDst, attachmentsSrc, attachmentsDst string) error {
+	_, err := os.Stat(commentsSrc)
+	hadComments := err == nil
+	if err := moveIfExists(commentsSrc, commentsDst); err != nil {
+		return fmt.Errorf("move comments file: %w", err)
+	}
+	if err := moveIfExists(attachmentsSrc, attachmentsDst); err != nil {
+		if hadComments {
+			_ = os.Rename(commentsDst, commentsSrc)
+		}
+		return fmt.Errorf("move attachments dir: %w", err)
+	}
+	return nil
+}
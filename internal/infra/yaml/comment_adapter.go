@@ -0,0 +1,36 @@
+package yaml
+
+import (
+	"context"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// CommentRepositoryAdapter adapts Store to satisfy domain.CommentRepository interface.
+type CommentRepositoryAdapter struct {
+	store *Store
+}
+
+func NewCommentRepositoryAdapter(store *Store) *CommentRepositoryAdapter {
+	return &CommentRepositoryAdapter{store: store}
+}
+
+func (a *CommentRepositoryAdapter) ListByCard(ctx context.Context, boardID, cardID string) ([]domain.Comment, error) {
+	return a.store.ListComments(ctx, boardID, cardID)
+}
+
+func (a *CommentRepositoryAdapter) Get(ctx context.Context, boardID, cardID, commentID string) (*domain.Comment, error) {
+	return a.store.GetComment(ctx, boardID, cardID, commentID)
+}
+
+func (a *CommentRepositoryAdapter) Create(ctx context.Context, boardID, cardID string, comment *domain.Comment) (string, error) {
+	return a.store.CreateComment(ctx, boardID, cardID, comment)
+}
+
+func (a *CommentRepositoryAdapter) Save(ctx context.Context, boardID, cardID string, comment *domain.Comment) error {
+	return a.store.SaveComment(ctx, boardID, cardID, comment)
+}
+
+func (a *CommentRepositoryAdapter) Delete(ctx context.Context, boardID, cardID, commentID string) error {
+	return a.store.DeleteComment(ctx, boardID, cardID, commentID)
+}
@@ -0,0 +1,139 @@
+package yaml
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func (s *Store) commentsDir(boardID string) string {
+	return filepath.Join(s.boardDir(boardID), "comments")
+}
+
+func (s *Store) commentsFile(boardID, cardID string) string {
+	return filepath.Join(s.commentsDir(boardID), cardID+".yaml")
+}
+
+// CommentRepository implementation
+
+func (s *Store) ListComments(_ context.Context, boardID, cardID string) ([]domain.Comment, error) {
+	defer s.rlockBoard(boardID)()
+
+	return s.readComments(boardID, cardID)
+}
+
+func (s *Store) GetComment(_ context.Context, boardID, cardID, commentID string) (*domain.Comment, error) {
+	defer s.rlockBoard(boardID)()
+
+	comments, err := s.readComments(boardID, cardID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range comments {
+		if comments[i].ID == commentID {
+			return &comments[i], nil
+		}
+	}
+	return nil, &domain.ErrNotFound{Resource: "comment", ID: commentID}
+}
+
+func (s *Store) CreateComment(_ context.Context, boardID, cardID string, comment *domain.Comment) (string, error) {
+	defer s.lockBoard(boardID)()
+
+	comments, err := s.readComments(boardID, cardID)
+	if err != nil {
+		return "", err
+	}
+
+	maxSeq := 0
+	for _, c := range comments {
+		if seq, err := strconv.Atoi(c.ID); err == nil && seq > maxSeq {
+			maxSeq = seq
+		}
+	}
+	comment.ID = strconv.Itoa(maxSeq + 1)
+
+	if err := s.writeComments(boardID, cardID, append(comments, *comment)); err != nil {
+		return "", err
+	}
+	return comment.ID, nil
+}
+
+func (s *Store) SaveComment(_ context.Context, boardID, cardID string, comment *domain.Comment) error {
+	defer s.lockBoard(boardID)()
+
+	comments, err := s.readComments(boardID, cardID)
+	if err != nil {
+		return err
+	}
+	for i := range comments {
+		if comments[i].ID == comment.ID {
+			comments[i] = *comment
+			return s.writeComments(boardID, cardID, comments)
+		}
+	}
+	return s.writeComments(boardID, cardID, append(comments, *comment))
+}
+
+func (s *Store) DeleteComment(_ context.Context, boardID, cardID, commentID string) error {
+	defer s.lockBoard(boardID)()
+
+	comments, err := s.readComments(boardID, cardID)
+	if err != nil {
+		return err
+	}
+	for i := range comments {
+		if comments[i].ID == commentID {
+			return s.writeComments(boardID, cardID, append(comments[:i], comments[i+1:]...))
+		}
+	}
+	return &domain.ErrNotFound{Resource: "comment", ID: commentID}
+}
+
+func (s *Store) readComments(boardID, cardID string) ([]domain.Comment, error) {
+	data, err := os.ReadFile(s.commentsFile(boardID, cardID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []domain.Comment{}, nil
+		}
+		return nil, fmt.Errorf("read comments file: %w", err)
+	}
+
+	comments := []domain.Comment{}
+	if err := yamlv3.Unmarshal(data, &comments); err != nil {
+		return nil, fmt.Errorf("unmarshal comments: %w", err)
+	}
+	return comments, nil
+}
+
+// writeComments replaces the comment file of a card, removing it once the
+// last comment is gone.
+func (s *Store) writeComments(boardID, cardID string, comments []domain.Comment) error {
+	path := s.commentsFile(boardID, cardID)
+	if len(comments) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove comments file: %w", err)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(s.commentsDir(boardID), 0o755); err != nil {
+		return fmt.Errorf("create comments dir: %w", err)
+	}
+
+	data, err := yamlv3.Marshal(comments)
+	if err != nil {
+		return fmt.Errorf("marshal comments: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write comments file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,311 @@
+package yaml
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// IDReassignment records a card given a new ID because another card of its
+// board had the same one. File is the file the card was found in, relative
+// to the base path.
+type IDReassignment struct {
+	BoardID string `json:"board_id"`
+	OldID   string `json:"old_id"`
+	NewID   string `json:"new_id"`
+	File    string `json:"file"`
+}
+
+// IDConflictResult is what ResolveIDConflicts did, or would do with dryRun,
+// on a board.
+type IDConflictResult struct {
+	Reassignments []IDReassignment
+	// Unresolved lists the files, relative to the base path, left with
+	// conflict markers that do not hold two cards added under one ID, such
+	// as a card both branches edited. They are left for the user to merge.
+	Unresolved []string
+}
+
+// IDReference is a card whose parent or blocked_by names an ID that two
+// cards had: it may have meant the card that now has the new ID.
+type IDReference struct {
+	BoardID string `json:"board_id"`
+	CardID  string `json:"card_id"`
+	// Field is "parent" or "blocked_by".
+	Field string         `json:"field"`
+	Ref   domain.CardRef `json:"ref"`
+}
+
+// idClaim is a card found in a card file, claiming its ID.
+type idClaim struct {
+	path string
+	card *domain.Card
+	// split marks a side of a file with conflict markers.
+	split bool
+}
+
+// ResolveIDConflicts gives a new ID, in the board's ID format, to every card
+// whose ID another card of the board also has. Merging git branches that
+// each created cards leaves such cards behind in two ways:
+//
+//   - both branches added the same file, and git wrote both versions into it
+//     between conflict markers: the file is split, our side keeping the ID;
+//   - the branches wrote the same ID in different formats (X.yaml and X.md):
+//     the YAML file keeps the ID.
+//
+// A file with conflict markers is only split when its sides are two cards:
+// a diff3 base section, if any, is empty, and the sides have different
+// created_at times. Others, such as one card edited on both branches, are
+// reported as unresolved and left as they are.
+//
+// Comments and attachments stay with the card that keeps the ID; references
+// to the old ID are not changed (see FindIDReferences). With dryRun nothing
+// is written, and the result reports what would change.
+func (s *Store) ResolveIDConflicts(_ context.Context, boardID string, dryRun bool) (*IDConflictResult, error) {
+	defer s.lockBoard(boardID)()
+
+	result := &IDConflictResult{}
+	dir := s.cardsDir(boardID)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return result, nil
+		}
+		return nil, fmt.Errorf("read cards dir: %w", err)
+	}
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && isCardFileName(e.Name()) {
+			names = append(names, e.Name())
+		}
+	}
+	// X.yaml comes before X.md, so that it keeps the ID.
+	sort.Slice(names, func(i, j int) bool {
+		si, sj := strings.TrimSuffix(names[i], filepath.Ext(names[i])), strings.TrimSuffix(names[j], filepath.Ext(names[j]))
+		if si != sj {
+			return si < sj
+		}
+		return filepath.Ext(names[i]) == yamlExt
+	})
+
+	var claims []idClaim
+	// held are the IDs of unresolved files, which no new ID may reuse.
+	var held []string
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read card file: %w", err)
+		}
+		ours, theirs, base, ok := splitConflict(data)
+		if !ok {
+			card, err := decodeClaim(path, data)
+			if err != nil {
+				continue
+			}
+			claims = append(claims, idClaim{path: path, card: card})
+			continue
+		}
+		ourCard, err := decodeClaim(path, ours)
+		if err != nil {
+			return nil, fmt.Errorf("%s: our side of the conflict: %w", name, err)
+		}
+		theirCard, err := decodeClaim(path, theirs)
+		if err != nil {
+			return nil, fmt.Errorf("%s: their side of the conflict: %w", name, err)
+		}
+		if len(bytes.TrimSpace(base)) > 0 || !addedSeparately(ourCard, theirCard) {
+			result.Unresolved = append(result.Unresolved, s.relPath(path))
+			held = append(held, ourCard.ID, theirCard.ID)
+			continue
+		}
+		claims = append(claims, idClaim{path: path, card: ourCard, split: true}, idClaim{path: path, card: theirCard, split: true})
+	}
+
+	board, err := s.readBoard(boardID)
+	if err != nil {
+		board = nil
+	}
+	taken := append(make([]string, 0, len(claims)+len(held)), held...)
+	for _, c := range claims {
+		taken = append(taken, c.card.ID)
+	}
+	counter := s.readIDCounter(boardID)
+	startCounter := counter
+
+	owned := make(map[string]bool, len(claims))
+	for _, c := range claims {
+		if !owned[c.card.ID] {
+			owned[c.card.ID] = true
+			continue
+		}
+		var id string
+		id, counter = domain.NextCardID(board, taken, counter, time.Now())
+		taken = append(taken, id)
+		result.Reassignments = append(result.Reassignments, IDReassignment{BoardID: boardID, OldID: c.card.ID, NewID: id, File: s.relPath(c.path)})
+		if dryRun {
+			continue
+		}
+
+		c.card.ID = id
+		if c.split {
+			continue
+		}
+		if err := s.writeCardFile(s.cardFileAs(boardID, id, filepath.Ext(c.path)), c.card); err != nil {
+			return nil, err
+		}
+		if err := os.Remove(c.path); err != nil {
+			return nil, fmt.Errorf("remove card file: %w", err)
+		}
+		s.cards.remove(c.path)
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	// Both sides of a split file go to the files of their final IDs; the file
+	// with the markers is replaced if one of them is named by it, and
+	// removed otherwise.
+	kept := make(map[string]bool)
+	for _, c := range claims {
+		if !c.split {
+			continue
+		}
+		path := s.cardFileAs(boardID, c.card.ID, filepath.Ext(c.path))
+		if path == c.path {
+			kept[c.path] = true
+		}
+		if err := s.writeCardFile(path, c.card); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range claims {
+		if c.split && !kept[c.path] {
+			if err := os.Remove(c.path); err != nil && !os.IsNotExist(err) {
+				return nil, fmt.Errorf("remove card file: %w", err)
+			}
+			s.cards.remove(c.path)
+		}
+	}
+	if counter != startCounter {
+		if err := s.writeIDCounter(boardID, counter); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// FindIDReferences returns the cards, on any board, whose parent or
+// blocked_by names the old ID of a reassignment. Each may have meant either
+// card that had the ID, so the user has to check it.
+func (s *Store) FindIDReferences(ctx context.Context, reassignments []IDReassignment) ([]IDReference, error) {
+	old := make(map[domain.CardRef]bool, len(reassignments))
+	for _, r := range reassignments {
+		old[domain.CardRef{Board: r.BoardID, ID: r.OldID}] = true
+	}
+	if len(old) == 0 {
+		return nil, nil
+	}
+
+	boards, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var refs []IDReference
+	for _, b := range boards {
+		cards, err := s.ListByBoard(ctx, b.ID, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range cards {
+			if parent := (domain.CardRef{Board: b.ID, ID: c.Parent}); c.Parent != "" && old[parent] {
+				refs = append(refs, IDReference{BoardID: b.ID, CardID: c.ID, Field: "parent", Ref: parent})
+			}
+			for _, ref := range c.BlockedBy {
+				if ref.Board == "" {
+					ref.Board = b.ID
+				}
+				if old[ref] {
+					refs = append(refs, IDReference{BoardID: b.ID, CardID: c.ID, Field: "blocked_by", Ref: ref})
+				}
+			}
+		}
+	}
+	return refs, nil
+}
+
+// addedSeparately reports whether the two sides of a conflicted card file are
+// cards created on their own, rather than one card edited on both sides.
+func addedSeparately(ours, theirs *domain.Card) bool {
+	return !ours.CreatedAt.IsZero() && !theirs.CreatedAt.IsZero() && !ours.CreatedAt.Equal(theirs.CreatedAt)
+}
+
+// relPath returns path relative to the base path, with slashes.
+func (s *Store) relPath(path string) string {
+	rel, err := filepath.Rel(s.basePath, path)
+	if err != nil {
+		rel = path
+	}
+	return filepath.ToSlash(rel)
+}
+
+// decodeClaim decodes a card file, taking the ID from its name when the
+// file leaves it out, as readCardFile does.
+func decodeClaim(path string, data []byte) (*domain.Card, error) {
+	card, err := decodeCard(path, data)
+	if err != nil {
+		return nil, err
+	}
+	if card.ID == "" {
+		card.ID = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return card, nil
+}
+
+// splitConflict returns both sides of a file that git left with conflict
+// markers and, in the diff3 style, the lines of the common ancestor. ok is
+// false when the file has no conflict.
+func splitConflict(data []byte) (ours, theirs, base []byte, ok bool) {
+	const (
+		common = iota
+		inOurs
+		inBase
+		inTheirs
+	)
+	state := common
+	for _, line := range bytes.SplitAfter(data, []byte("\n")) {
+		switch {
+		case state == common && bytes.HasPrefix(line, []byte("<<<<<<<")):
+			state, ok = inOurs, true
+			continue
+		case state == inOurs && bytes.HasPrefix(line, []byte("|||||||")):
+			state = inBase
+			continue
+		case (state == inOurs || state == inBase) && bytes.HasPrefix(line, []byte("=======")):
+			state = inTheirs
+			continue
+		case state == inTheirs && bytes.HasPrefix(line, []byte(">>>>>>>")):
+			state = common
+			continue
+		}
+		switch state {
+		case common:
+			ours = append(ours, line...)
+			theirs = append(theirs, line...)
+		case inOurs:
+			ours = append(ours, line...)
+		case inBase:
+			base = append(base, line...)
+		case inTheirs:
+			theirs = append(theirs, line...)
+		}
+	}
+	return ours, theirs, base, ok
+}
@@ -0,0 +1,68 @@
+package yaml
+
+import (
+	"slices"
+	"sync"
+)
+
+// Locking: s.mu guards the set of boards. Operations on one board hold s.mu
+// for reading and that board's lock, so boards do not block each other.
+// Creating a board directory is safe under a read lock; moving whole boards
+// in or out, as deleting and restoring do, takes s.mu for writing. Locks are
+// always taken in the order s.mu, board locks by ascending ID, s.trashMu.
+
+// boardLocks hands out one RWMutex per board ID.
+type boardLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.RWMutex
+}
+
+func (l *boardLocks) get(boardID string) *sync.RWMutex {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.locks == nil {
+		l.locks = make(map[string]*sync.RWMutex)
+	}
+	m, ok := l.locks[boardID]
+	if !ok {
+		m = &sync.RWMutex{}
+		l.locks[boardID] = m
+	}
+	return m
+}
+
+// rlockBoard locks a board for reading and returns the unlock function.
+func (s *Store) rlockBoard(boardID string) func() {
+	s.mu.RLock()
+	m := s.boards.get(boardID)
+	m.RLock()
+	return func() {
+		m.RUnlock()
+		s.mu.RUnlock()
+	}
+}
+
+// lockBoard locks a board for writing and returns the unlock function.
+func (s *Store) lockBoard(boardID string) func() {
+	return s.lockBoards(boardID)
+}
+
+// lockBoards locks several boards for writing, in ID order so that two
+// operations spanning the same boards cannot deadlock.
+func (s *Store) lockBoards(boardIDs ...string) func() {
+	ids := slices.Compact(slices.Sorted(slices.Values(boardIDs)))
+
+	s.mu.RLock()
+	locked := make([]*sync.RWMutex, len(ids))
+	for i, id := range ids {
+		locked[i] = s.boards.get(id)
+		locked[i].Lock()
+	}
+	return func() {
+		for i := len(locked) - 1; i >= 0; i-- {
+			locked[i].Unlock()
+		}
+		s.mu.RUnlock()
+	}
+}
@@ -0,0 +1,86 @@
+package yaml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// A Markdown card file keeps the card's fields as YAML frontmatter and its
+// description as the body:
+//
+//	---
+//	id: 20260124-001
+//	title: Write the docs
+//	list: todo
+//	rank: i
+//	---
+//	Free-form **Markdown**, written as is.
+
+const frontmatterDelim = "---"
+
+// marshalMarkdownCard encodes a card as frontmatter and body. The description
+// is left out of the frontmatter and written verbatim after it.
+func marshalMarkdownCard(card *domain.Card) ([]byte, error) {
+	c := *card
+	c.Order = 0
+	var node yamlv3.Node
+	if err := node.Encode(&c); err != nil {
+		return nil, fmt.Errorf("marshal card: %w", err)
+	}
+	deleteMappingKey(&node, "description")
+	front, err := yamlv3.Marshal(&node)
+	if err != nil {
+		return nil, fmt.Errorf("marshal card: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(frontmatterDelim + "\n")
+	buf.Write(front)
+	buf.WriteString(frontmatterDelim + "\n")
+	buf.WriteString(card.Description)
+	return buf.Bytes(), nil
+}
+
+// unmarshalMarkdownCard decodes a Markdown card file. A description in the
+// frontmatter is only used when the body is empty.
+func unmarshalMarkdownCard(data []byte) (*domain.Card, error) {
+	front, body, err := splitFrontmatter(data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal card: %w", err)
+	}
+	card, err := unmarshalCard(front)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > 0 {
+		card.Description = string(body)
+	}
+	return card, nil
+}
+
+// splitFrontmatter returns the YAML between the opening and closing "---"
+// lines and everything after the closing one.
+func splitFrontmatter(data []byte) (front, body []byte, err error) {
+	line, rest, _ := bytes.Cut(data, []byte("\n"))
+	if string(bytes.TrimRight(line, "\r")) != frontmatterDelim {
+		return nil, nil, errors.New("missing frontmatter")
+	}
+	for i := 0; i < len(rest); {
+		end := len(rest)
+		next := end
+		if j := bytes.IndexByte(rest[i:], '\n'); j >= 0 {
+			end = i + j
+			next = end + 1
+		}
+		if string(bytes.TrimRight(rest[i:end], "\r")) == frontmatterDelim {
+			return rest[:i], rest[next:], nil
+		}
+		i = next
+	}
+	return nil, nil, errors.New("unterminated frontmatter")
+}
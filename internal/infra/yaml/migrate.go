@@ -0,0 +1,289 @@
+package yaml
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// SchemaVersion is the version of the board and card files this build reads
+// and writes. Migrate upgrades older data; newer data is refused.
+const SchemaVersion = 1
+
+// migration upgrades board and card files to version from the version before
+// it. Each function gets the YAML mapping of one file and reports whether it
+// changed it. A step interrupted halfway is run again in full, so it must
+// leave files it has already upgraded as they are.
+type migration struct {
+	version     int
+	description string
+	board       func(board *yamlv3.Node) (bool, error)
+	card        func(card *yamlv3.Node) (bool, error)
+}
+
+var migrations = []migration{
+	{
+		version:     1,
+		description: "store a rank in cards that only have the legacy order",
+		card:        migrateOrderToRank,
+	},
+}
+
+// ErrSchemaTooNew reports data written by a newer build.
+type ErrSchemaTooNew struct {
+	Version int
+}
+
+func (e *ErrSchemaTooNew) Error() string {
+	return fmt.Sprintf("data schema version %d is newer than %d, the latest this build supports; upgrade taskmgr", e.Version, SchemaVersion)
+}
+
+// CheckSchemaVersion returns *ErrSchemaTooNew if data at version cannot be
+// read by this build.
+func CheckSchemaVersion(version int) error {
+	if version > SchemaVersion {
+		return &ErrSchemaTooNew{Version: version}
+	}
+	return nil
+}
+
+// MigrationStep is one step of Migrate with the files it changed or, in a dry
+// run, would change. Paths are relative to the base path.
+type MigrationStep struct {
+	Version     int      `json:"version"`
+	Description string   `json:"description"`
+	Files       []string `json:"files"`
+}
+
+// migrationFile is a board or card file loaded for migration. body is the
+// description of a Markdown card, which the steps do not see.
+type migrationFile struct {
+	path     string
+	card     bool
+	markdown bool
+	doc      yamlv3.Node
+	body     []byte
+}
+
+// Migrate upgrades every board and card, including those in the trash, from
+// version from to SchemaVersion one step at a time. The files changed by a
+// step are written before the next step runs. With dryRun nothing is
+// written, and the steps report what they would change. Recording the new
+// version is left to the caller.
+func (s *Store) Migrate(_ context.Context, from int, dryRun bool) ([]MigrationStep, error) {
+	if err := CheckSchemaVersion(from); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var pending []migration
+	for _, m := range migrations {
+		if m.version > from {
+			pending = append(pending, m)
+		}
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	files, err := s.loadMigrationFiles()
+	if err != nil {
+		return nil, err
+	}
+	steps := make([]MigrationStep, 0, len(pending))
+	for _, m := range pending {
+		step := MigrationStep{Version: m.version, Description: m.description, Files: []string{}}
+		for _, f := range files {
+			fn := m.board
+			if f.card {
+				fn = m.card
+			}
+			if fn == nil || len(f.doc.Content) == 0 || f.doc.Content[0].Kind != yamlv3.MappingNode {
+				continue
+			}
+			changed, err := fn(f.doc.Content[0])
+			if err != nil {
+				return nil, fmt.Errorf("migrate %s to version %d: %w", f.path, m.version, err)
+			}
+			if !changed {
+				continue
+			}
+			rel, err := filepath.Rel(s.basePath, f.path)
+			if err != nil {
+				rel = f.path
+			}
+			step.Files = append(step.Files, filepath.ToSlash(rel))
+			if !dryRun {
+				if err := s.writeMigrationFile(f); err != nil {
+					return nil, err
+				}
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+// loadMigrationFiles reads the board and card files of every board and of
+// the trash.
+func (s *Store) loadMigrationFiles() ([]*migrationFile, error) {
+	var boardDirs, cardFiles []string
+	if entries, err := os.ReadDir(filepath.Join(s.basePath, "boards")); err == nil {
+		for _, e := range entries {
+			if e.IsDir() {
+				boardDirs = append(boardDirs, s.boardDir(e.Name()))
+			}
+		}
+	}
+	if entries, err := os.ReadDir(s.trashDir()); err == nil {
+		for _, e := range entries {
+			if !e.IsDir() {
+				continue
+			}
+			dir := s.trashItemDir(e.Name())
+			boardDirs = append(boardDirs, filepath.Join(dir, "board"))
+			for _, ext := range []string{yamlExt, markdownExt} {
+				cardFiles = append(cardFiles, filepath.Join(dir, "card"+ext))
+			}
+		}
+	}
+
+	var files []*migrationFile
+	add := func(path string, card bool) error {
+		f, err := readMigrationFile(path, card)
+		if err != nil || f == nil {
+			return err
+		}
+		files = append(files, f)
+		return nil
+	}
+	for _, dir := range boardDirs {
+		if err := add(filepath.Join(dir, "board.yaml"), false); err != nil {
+			return nil, err
+		}
+		entries, err := os.ReadDir(filepath.Join(dir, "cards"))
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			if !e.IsDir() && isCardFileName(e.Name()) {
+				if err := add(filepath.Join(dir, "cards", e.Name()), true); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	for _, path := range cardFiles {
+		if err := add(path, true); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// readMigrationFile parses the file at path, or returns nil if there is none.
+func readMigrationFile(path string, card bool) (*migrationFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	f := &migrationFile{path: path, card: card, markdown: filepath.Ext(path) == markdownExt}
+	if f.markdown {
+		front, body, err := splitFrontmatter(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", path, err)
+		}
+		data, f.body = front, body
+	}
+	if err := yamlv3.Unmarshal(data, &f.doc); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return f, nil
+}
+
+// writeMigrationFile replaces the file with its migrated contents through a
+// temporary file, so that it is never left half written.
+func (s *Store) writeMigrationFile(f *migrationFile) error {
+	data, err := yamlv3.Marshal(&f.doc)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", f.path, err)
+	}
+	if f.markdown {
+		var buf bytes.Buffer
+		buf.WriteString(frontmatterDelim + "\n")
+		buf.Write(data)
+		buf.WriteString(frontmatterDelim + "\n")
+		buf.Write(f.body)
+		data = buf.Bytes()
+	}
+
+	tmp := f.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("write %s: %w", f.path, err)
+	}
+	s.cards.remove(f.path)
+	if err := os.Rename(tmp, f.path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("write %s: %w", f.path, err)
+	}
+	return nil
+}
+
+// mappingValue returns the value of key in mapping, or nil.
+func mappingValue(mapping *yamlv3.Node, key string) *yamlv3.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setMappingValue sets key to value, appending the key if it is missing.
+func setMappingValue(mapping *yamlv3.Node, key string, value *yamlv3.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content[i+1] = value
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+func deleteMappingKey(mapping *yamlv3.Node, key string) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+// migrateOrderToRank gives cards written before ranks existed the rank that
+// unmarshalCard would derive from their order, and drops the order.
+func migrateOrderToRank(card *yamlv3.Node) (bool, error) {
+	if rank := mappingValue(card, "rank"); rank != nil && rank.Value != "" {
+		return false, nil
+	}
+	order := 0
+	if v := mappingValue(card, "order"); v != nil {
+		if err := v.Decode(&order); err != nil {
+			return false, fmt.Errorf("decode order: %w", err)
+		}
+	}
+	deleteMappingKey(card, "order")
+	setMappingValue(card, "rank", &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: domain.RankFromOrder(order)})
+	return true, nil
+}
@@ -0,0 +1,76 @@
+package yaml
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func (s *Store) recurringFile(boardID string) string {
+	return filepath.Join(s.boardDir(boardID), "recurring.yaml")
+}
+
+// RecurringRepository implementation
+
+func (s *Store) ListRecurring(_ context.Context, boardID string) ([]domain.RecurringCard, error) {
+	defer s.rlockBoard(boardID)()
+
+	return s.readRecurring(boardID)
+}
+
+// SaveRecurring replaces the template with the same ID, or appends it.
+func (s *Store) SaveRecurring(_ context.Context, boardID string, rc *domain.RecurringCard) error {
+	defer s.lockBoard(boardID)()
+
+	items, err := s.readRecurring(boardID)
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+	for i := range items {
+		if items[i].ID == rc.ID {
+			items[i] = *rc
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		items = append(items, *rc)
+	}
+
+	data, err := yamlv3.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("marshal recurring: %w", err)
+	}
+
+	if err := os.MkdirAll(s.boardDir(boardID), 0o755); err != nil {
+		return fmt.Errorf("create board dir: %w", err)
+	}
+
+	if err := os.WriteFile(s.recurringFile(boardID), data, 0o644); err != nil {
+		return fmt.Errorf("write recurring file: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) readRecurring(boardID string) ([]domain.RecurringCard, error) {
+	data, err := os.ReadFile(s.recurringFile(boardID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []domain.RecurringCard{}, nil
+		}
+		return nil, fmt.Errorf("read recurring file: %w", err)
+	}
+
+	items := []domain.RecurringCard{}
+	if err := yamlv3.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("unmarshal recurring: %w", err)
+	}
+	return items, nil
+}
@@ -0,0 +1,24 @@
+package yaml
+
+import (
+	"context"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// RecurringRepositoryAdapter adapts Store to satisfy domain.RecurringRepository interface.
+type RecurringRepositoryAdapter struct {
+	store *Store
+}
+
+func NewRecurringRepositoryAdapter(store *Store) *RecurringRepositoryAdapter {
+	return &RecurringRepositoryAdapter{store: store}
+}
+
+func (a *RecurringRepositoryAdapter) ListByBoard(ctx context.Context, boardID string) ([]domain.RecurringCard, error) {
+	return a.store.ListRecurring(ctx, boardID)
+}
+
+func (a *RecurringRepositoryAdapter) Save(ctx context.Context, boardID string, rc *domain.RecurringCard) error {
+	return a.store.SaveRecurring(ctx, boardID, rc)
+}
@@ -3,9 +3,10 @@ package yaml
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
-	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -17,11 +18,15 @@ import (
 
 type Store struct {
 	basePath string
-	mu       sync.RWMutex
+	// mu, boards and trashMu are taken as described in lock.go.
+	mu      sync.RWMutex
+	boards  boardLocks
+	trashMu sync.Mutex
+	cards   *cardCache
 }
 
 func NewStore(basePath string) *Store {
-	return &Store{basePath: basePath}
+	return &Store{basePath: basePath, cards: newCardCache()}
 }
 
 func (s *Store) boardDir(id string) string {
@@ -36,8 +41,35 @@ func (s *Store) cardsDir(boardID string) string {
 	return filepath.Join(s.boardDir(boardID), "cards")
 }
 
+// Card files are YAML or, for boards with CardFormatMarkdown, Markdown with
+// YAML frontmatter (markdown.go). A board can hold cards of both formats.
+const (
+	yamlExt     = ".yaml"
+	markdownExt = ".md"
+)
+
+// cardFile returns the path of a card's file: the one on disk, whichever
+// format it is in, or else a new one in the board's card format.
 func (s *Store) cardFile(boardID, cardID string) string {
-	return filepath.Join(s.cardsDir(boardID), cardID+".yaml")
+	for _, ext := range []string{yamlExt, markdownExt} {
+		path := s.cardFileAs(boardID, cardID, ext)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	board, err := s.readBoard(boardID)
+	if err != nil {
+		board = nil
+	}
+	return s.cardFileAs(boardID, cardID, cardExt(board))
+}
+
+func (s *Store) cardFileAs(boardID, cardID, ext string) string {
+	return filepath.Join(s.cardsDir(boardID), cardID+ext)
+}
+
+func isCardFileName(name string) bool {
+	return strings.HasSuffix(name, yamlExt) || strings.HasSuffix(name, markdownExt)
 }
 
 // BoardRepository implementation
@@ -60,7 +92,10 @@ func (s *Store) List(_ context.Context) ([]domain.Board, error) {
 		if !entry.IsDir() {
 			continue
 		}
+		m := s.boards.get(entry.Name())
+		m.RLock()
 		board, err := s.readBoard(entry.Name())
+		m.RUnlock()
 		if err != nil {
 			continue
 		}
@@ -70,15 +105,13 @@ func (s *Store) List(_ context.Context) ([]domain.Board, error) {
 }
 
 func (s *Store) Get(_ context.Context, id string) (*domain.Board, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	defer s.rlockBoard(id)()
 
 	return s.readBoard(id)
 }
 
 func (s *Store) Save(_ context.Context, board *domain.Board) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	defer s.lockBoard(board.ID)()
 
 	dir := s.boardDir(board.ID)
 	if err := os.MkdirAll(dir, 0o755); err != nil {
@@ -107,7 +140,7 @@ func (s *Store) Delete(_ context.Context, id string) error {
 	if _, err := os.Stat(dir); os.IsNotExist(err) {
 		return &domain.ErrNotFound{Resource: "board", ID: id}
 	}
-	return os.RemoveAll(dir)
+	return s.trashBoardLocked(id)
 }
 
 func (s *Store) readBoard(id string) (*domain.Board, error) {
@@ -129,8 +162,7 @@ func (s *Store) readBoard(id string) (*domain.Board, error) {
 // CardRepository implementation
 
 func (s *Store) ListByBoard(_ context.Context, boardID string, includeArchived bool) ([]domain.Card, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	defer s.rlockBoard(boardID)()
 
 	dir := s.cardsDir(boardID)
 	entries, err := os.ReadDir(dir)
@@ -143,10 +175,14 @@ func (s *Store) ListByBoard(_ context.Context, boardID string, includeArchived b
 
 	var cards []domain.Card
 	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+		if entry.IsDir() || !isCardFileName(entry.Name()) {
 			continue
 		}
-		card, err := s.readCard(boardID, strings.TrimSuffix(entry.Name(), ".yaml"))
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		card, err := s.readCardFile(filepath.Join(dir, entry.Name()), info)
 		if err != nil {
 			continue
 		}
@@ -156,96 +192,130 @@ func (s *Store) ListByBoard(_ context.Context, boardID string, includeArchived b
 		cards = append(cards, *card)
 	}
 
-	sort.SliceStable(cards, func(i, j int) bool {
-		if cards[i].List != cards[j].List {
-			return cards[i].List < cards[j].List
-		}
-		return cards[i].Order < cards[j].Order
-	})
-
+	domain.SortByRank(cards)
 	return cards, nil
 }
 
 func (s *Store) GetCard(_ context.Context, boardID, cardID string) (*domain.Card, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	defer s.rlockBoard(boardID)()
 
 	return s.readCard(boardID, cardID)
 }
 
 func (s *Store) SaveCard(_ context.Context, boardID string, card *domain.Card) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	defer s.lockBoard(boardID)()
 
 	dir := s.cardsDir(boardID)
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		return fmt.Errorf("create cards dir: %w", err)
 	}
 
-	data, err := yamlv3.Marshal(card)
-	if err != nil {
-		return fmt.Errorf("marshal card: %w", err)
-	}
-
-	if err := os.WriteFile(s.cardFile(boardID, card.ID), data, 0o644); err != nil {
-		return fmt.Errorf("write card file: %w", err)
-	}
-	return nil
+	return s.writeCardFile(s.cardFile(boardID, card.ID), card)
 }
 
 func (s *Store) DeleteCard(_ context.Context, boardID, cardID string) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	defer s.lockBoard(boardID)()
 
 	path := s.cardFile(boardID, cardID)
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return &domain.ErrNotFound{Resource: "card", ID: cardID}
 	}
-	return os.Remove(path)
+	_, err := s.trashCardLocked(boardID, cardID)
+	return err
 }
 
 func (s *Store) NextID(_ context.Context, boardID string) (string, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	defer s.lockBoard(boardID)()
 
 	return s.nextIDLocked(boardID)
 }
 
+// nextIDLocked returns a new card ID in the board's ID format. On a prefix
+// board it also advances the counter kept in the board directory.
 func (s *Store) nextIDLocked(boardID string) (string, error) {
-	today := time.Now().Format("20060102")
-	dir := s.cardsDir(boardID)
+	taken, err := s.cardIDs(boardID)
+	if err != nil {
+		return "", err
+	}
+	board, err := s.readBoard(boardID)
+	if err != nil {
+		board = nil
+	}
+	counter := s.readIDCounter(boardID)
 
-	entries, err := os.ReadDir(dir)
+	id, next := domain.NextCardID(board, taken, counter, time.Now())
+	if next != counter {
+		if err := s.writeIDCounter(boardID, next); err != nil {
+			return "", err
+		}
+	}
+	return id, nil
+}
+
+// cardIDs returns the IDs named by the board's card files.
+func (s *Store) cardIDs(boardID string) ([]string, error) {
+	entries, err := os.ReadDir(s.cardsDir(boardID))
 	if err != nil {
 		if os.IsNotExist(err) {
-			return today + "-001", nil
+			return nil, nil
 		}
-		return "", fmt.Errorf("read cards dir: %w", err)
+		return nil, fmt.Errorf("read cards dir: %w", err)
 	}
-
-	maxSeq := 0
+	var ids []string
 	for _, entry := range entries {
-		name := strings.TrimSuffix(entry.Name(), ".yaml")
-		if strings.HasPrefix(name, today+"-") {
-			seqStr := strings.TrimPrefix(name, today+"-")
-			seq := 0
-			for _, ch := range seqStr {
-				if ch >= '0' && ch <= '9' {
-					seq = seq*10 + int(ch-'0')
-				}
-			}
-			if seq > maxSeq {
-				maxSeq = seq
-			}
+		if name := entry.Name(); !entry.IsDir() && isCardFileName(name) {
+			ids = append(ids, strings.TrimSuffix(name, filepath.Ext(name)))
 		}
 	}
+	return ids, nil
+}
+
+// idCounterFile holds the last number issued on a prefix board. It is not a
+// YAML file, so the watcher ignores it. A missing or unreadable counter,
+// such as one left with conflict markers by a git merge, counts as 0:
+// NextCardID never reissues a number in use anyway.
+func (s *Store) idCounterFile(boardID string) string {
+	return filepath.Join(s.boardDir(boardID), "id-counter")
+}
+
+func (s *Store) readIDCounter(boardID string) int {
+	data, err := os.ReadFile(s.idCounterFile(boardID))
+	if err != nil {
+		return 0
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func (s *Store) writeIDCounter(boardID string, counter int) error {
+	if err := os.WriteFile(s.idCounterFile(boardID), []byte(strconv.Itoa(counter)+"\n"), 0o644); err != nil {
+		return fmt.Errorf("write id counter: %w", err)
+	}
+	return nil
+}
+
+// IDCounterRepository implementation
+
+func (s *Store) IDCounter(_ context.Context, boardID string) (int, error) {
+	defer s.rlockBoard(boardID)()
 
-	return fmt.Sprintf("%s-%03d", today, maxSeq+1), nil
+	return s.readIDCounter(boardID), nil
+}
+
+func (s *Store) SetIDCounter(_ context.Context, boardID string, counter int) error {
+	defer s.lockBoard(boardID)()
+
+	if _, err := os.Stat(s.boardDir(boardID)); os.IsNotExist(err) {
+		return &domain.ErrNotFound{Resource: "board", ID: boardID}
+	}
+	return s.writeIDCounter(boardID, counter)
 }
 
 func (s *Store) CreateCard(_ context.Context, boardID string, card *domain.Card) (string, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	defer s.lockBoard(boardID)()
 
 	id, err := s.nextIDLocked(boardID)
 	if err != nil {
@@ -258,33 +328,165 @@ func (s *Store) CreateCard(_ context.Context, boardID string, card *domain.Card)
 		return "", fmt.Errorf("create cards dir: %w", err)
 	}
 
-	data, err := yamlv3.Marshal(card)
-	if err != nil {
-		return "", fmt.Errorf("marshal card: %w", err)
+	if err := s.writeCardFile(s.cardFile(boardID, card.ID), card); err != nil {
+		return "", err
 	}
+	return id, nil
+}
 
-	if err := os.WriteFile(s.cardFile(boardID, card.ID), data, 0o644); err != nil {
-		return "", fmt.Errorf("write card file: %w", err)
+// TransferCard moves a card file with its comments and attachments to another
+// board, keeping its format. If the card's ID already exists there, a new ID
+// is generated and card.ID is updated.
+func (s *Store) TransferCard(_ context.Context, fromBoardID, toBoardID string, card *domain.Card) (string, error) {
+	defer s.lockBoards(fromBoardID, toBoardID)()
+
+	oldID := card.ID
+	src := s.cardFile(fromBoardID, oldID)
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return "", &domain.ErrNotFound{Resource: "card", ID: oldID}
 	}
-	return id, nil
+
+	newID := oldID
+	if _, err := os.Stat(s.cardFile(toBoardID, oldID)); err == nil {
+		id, err := s.nextIDLocked(toBoardID)
+		if err != nil {
+			return "", err
+		}
+		newID = id
+	}
+	card.ID = newID
+
+	if err := os.MkdirAll(s.cardsDir(toBoardID), 0o755); err != nil {
+		return "", fmt.Errorf("create cards dir: %w", err)
+	}
+	if err := s.writeCardFile(s.cardFileAs(toBoardID, newID, filepath.Ext(src)), card); err != nil {
+		return "", err
+	}
+
+	if err := s.moveCardFilesLocked(domain.CardRef{Board: fromBoardID, ID: oldID}, domain.CardRef{Board: toBoardID, ID: newID}); err != nil {
+		return "", err
+	}
+
+	if err := os.Remove(src); err != nil {
+		return "", fmt.Errorf("remove card file: %w", err)
+	}
+	s.cards.remove(src)
+	return newID, nil
+}
+
+// moveIfExists renames src to dst, creating dst's parent directory. A missing
+// src is not an error.
+func moveIfExists(src, dst string) error {
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	return os.Rename(src, dst)
 }
 
 func (s *Store) readCard(boardID, cardID string) (*domain.Card, error) {
-	data, err := os.ReadFile(s.cardFile(boardID, cardID))
+	path := s.cardFile(boardID, cardID)
+	info, err := os.Stat(path)
 	if err != nil {
 		if os.IsNotExist(err) {
+			s.cards.remove(path)
 			return nil, &domain.ErrNotFound{Resource: "card", ID: cardID}
 		}
+		return nil, fmt.Errorf("stat card file: %w", err)
+	}
+	return s.readCardFile(path, info)
+}
+
+// readCardFile returns the card at path, parsing the file only if the cached
+// copy is missing or older than info.
+func (s *Store) readCardFile(path string, info fs.FileInfo) (*domain.Card, error) {
+	if card, ok := s.cards.get(path, info); ok {
+		return card, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
 		return nil, fmt.Errorf("read card file: %w", err)
 	}
+	card, err := decodeCard(path, data)
+	if err != nil {
+		return nil, err
+	}
+	// A hand-written file may leave the ID to its name.
+	if card.ID == "" {
+		card.ID = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	s.cards.put(path, info, card)
+	return card, nil
+}
+
+// writeCardFile writes card to path in the format its extension names.
+func (s *Store) writeCardFile(path string, card *domain.Card) error {
+	data, err := encodeCard(path, card)
+	if err != nil {
+		return err
+	}
+	s.cards.remove(path)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write card file: %w", err)
+	}
+	return nil
+}
 
+// encodeCard encodes a card for the file at path: Markdown for a .md file,
+// YAML otherwise.
+func encodeCard(path string, card *domain.Card) ([]byte, error) {
+	if filepath.Ext(path) == markdownExt {
+		return marshalMarkdownCard(card)
+	}
+	return marshalCard(card)
+}
+
+func decodeCard(path string, data []byte) (*domain.Card, error) {
+	if filepath.Ext(path) == markdownExt {
+		return unmarshalMarkdownCard(data)
+	}
+	return unmarshalCard(data)
+}
+
+// marshalCard encodes a card without its Order, which is derived from Rank.
+func marshalCard(card *domain.Card) ([]byte, error) {
+	c := *card
+	c.Order = 0
+	data, err := yamlv3.Marshal(&c)
+	if err != nil {
+		return nil, fmt.Errorf("marshal card: %w", err)
+	}
+	return data, nil
+}
+
+// unmarshalCard decodes a card file. Files written before ranks existed get
+// a rank from their stored order; it is saved the next time the card is.
+func unmarshalCard(data []byte) (*domain.Card, error) {
 	var card domain.Card
 	if err := yamlv3.Unmarshal(data, &card); err != nil {
 		return nil, fmt.Errorf("unmarshal card: %w", err)
 	}
+	if card.Rank == "" {
+		card.Rank = domain.RankFromOrder(card.Order)
+	}
 	return &card, nil
 }
 
+// Changed drops cached cards after the watcher saw their files change. An
+// empty cardID drops every card of the board.
+func (s *Store) Changed(_ context.Context, boardID, cardID string) error {
+	if cardID == "" {
+		s.cards.removeDir(s.boardDir(boardID))
+	} else {
+		s.cards.remove(s.cardFileAs(boardID, cardID, yamlExt))
+		s.cards.remove(s.cardFileAs(boardID, cardID, markdownExt))
+	}
+	return nil
+}
+
 // BasePath returns the store's base path for external use (e.g., file watcher).
 func (s *Store) BasePath() string {
 	return s.basePath
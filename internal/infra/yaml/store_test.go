@@ -3,9 +3,18 @@ package yaml_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/repotest"
 	yamlstore "github.com/hiroto-aibara/secretary-ai/internal/infra/yaml"
 )
 
@@ -15,6 +24,19 @@ func setupStore(t *testing.T) *yamlstore.Store {
 	return yamlstore.NewStore(dir)
 }
 
+func TestStore_Contract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repositories {
+		store := setupStore(t)
+		return repotest.Repositories{
+			Boards:      store,
+			Cards:       yamlstore.NewCardRepositoryAdapter(store),
+			Comments:    yamlstore.NewCommentRepositoryAdapter(store),
+			Attachments: yamlstore.NewAttachmentRepositoryAdapter(store),
+			Trash:       yamlstore.NewTrashRepositoryAdapter(store),
+		}
+	})
+}
+
 func TestStore_Board_CRUD(t *testing.T) {
 	store := setupStore(t)
 	ctx := context.Background()
@@ -230,3 +252,1101 @@ func TestStore_Card_GetNotFound(t *testing.T) {
 		t.Errorf("expected ErrNotFound, got %v", err)
 	}
 }
+
+func TestStore_Comment_CRUD(t *testing.T) {
+	store := setupStore(t)
+	cards := yamlstore.NewCardRepositoryAdapter(store)
+	comments := yamlstore.NewCommentRepositoryAdapter(store)
+	ctx := context.Background()
+
+	if err := cards.Save(ctx, "board-1", &domain.Card{ID: "card-1", Title: "Card", List: "todo"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create
+	id1, err := comments.Create(ctx, "board-1", "card-1", &domain.Comment{Author: "alice", Body: "first"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	id2, err := comments.Create(ctx, "board-1", "card-1", &domain.Comment{Author: "bob", Body: "second"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if id1 == id2 {
+		t.Errorf("comment IDs should differ: %s", id1)
+	}
+
+	// Comment files must not be listed as cards
+	listed, err := cards.ListByBoard(ctx, "board-1", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listed) != 1 {
+		t.Errorf("got %d cards, want 1", len(listed))
+	}
+
+	// Save
+	c, err := comments.Get(ctx, "board-1", "card-1", id1)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	c.Body = "edited"
+	if err := comments.Save(ctx, "board-1", "card-1", c); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	all, err := comments.ListByCard(ctx, "board-1", "card-1")
+	if err != nil {
+		t.Fatalf("ListByCard: %v", err)
+	}
+	if len(all) != 2 || all[0].Body != "edited" {
+		t.Errorf("comments = %+v, want 2 with first edited", all)
+	}
+
+	// Delete
+	if err := comments.Delete(ctx, "board-1", "card-1", id2); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	_, err = comments.Get(ctx, "board-1", "card-1", id2)
+	var notFound *domain.ErrNotFound
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound after delete, got %v", err)
+	}
+
+	// Deleting the card removes its comments
+	if err := cards.Delete(ctx, "board-1", "card-1"); err != nil {
+		t.Fatal(err)
+	}
+	all, err = comments.ListByCard(ctx, "board-1", "card-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 0 {
+		t.Errorf("got %d comments after card delete, want 0", len(all))
+	}
+}
+
+func TestStore_Attachment(t *testing.T) {
+	store := setupStore(t)
+	cards := yamlstore.NewCardRepositoryAdapter(store)
+	attachments := yamlstore.NewAttachmentRepositoryAdapter(store)
+	ctx := context.Background()
+
+	if err := cards.Save(ctx, "board-1", &domain.Card{ID: "card-1", Title: "Card", List: "todo"}); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := attachments.Put(ctx, "board-1", "card-1", "a1", strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if n != 5 {
+		t.Errorf("Put wrote %d bytes, want 5", n)
+	}
+
+	rc, err := attachments.Open(ctx, "board-1", "card-1", "a1")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	data, err := io.ReadAll(rc)
+	rc.Close()
+	if err != nil || string(data) != "hello" {
+		t.Errorf("content = %q, %v; want hello", data, err)
+	}
+
+	// Deleting the card removes its attachments
+	if err := cards.Delete(ctx, "board-1", "card-1"); err != nil {
+		t.Fatal(err)
+	}
+	_, err = attachments.Open(ctx, "board-1", "card-1", "a1")
+	var notFound *domain.ErrNotFound
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound after card delete, got %v", err)
+	}
+}
+
+func TestStore_Card_FieldsRoundTrip(t *testing.T) {
+	store := setupStore(t)
+	adapter := yamlstore.NewCardRepositoryAdapter(store)
+	ctx := context.Background()
+
+	board := &domain.Board{
+		ID:    "board-1",
+		Name:  "Board",
+		Lists: []domain.List{{ID: "todo", Name: "Todo"}},
+		Fields: []domain.FieldDef{
+			{ID: "points", Name: "Points", Type: domain.FieldNumber},
+			{ID: "due", Name: "Due", Type: domain.FieldDate},
+			{ID: "flaky", Name: "Flaky", Type: domain.FieldBool},
+		},
+	}
+	if err := store.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+
+	card := &domain.Card{ID: "card-1", Title: "Bug", List: "todo", Fields: map[string]any{
+		"points": 3.0,
+		"due":    "2026-01-24",
+		"flaky":  true,
+	}}
+	if err := adapter.Save(ctx, "board-1", card); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := adapter.Get(ctx, "board-1", "card-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotBoard, err := store.Get(ctx, "board-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := gotBoard.ValidateFieldValues(got.Fields); err != nil {
+		t.Errorf("field values do not survive a round trip: %v (%#v)", err, got.Fields)
+	}
+}
+
+func TestStore_TransferCard(t *testing.T) {
+	store := setupStore(t)
+	cards := yamlstore.NewCardRepositoryAdapter(store)
+	comments := yamlstore.NewCommentRepositoryAdapter(store)
+	attachments := yamlstore.NewAttachmentRepositoryAdapter(store)
+	ctx := context.Background()
+
+	for _, c := range []struct{ board, id string }{{"board-1", "card-1"}, {"board-1", "card-2"}, {"board-2", "card-2"}} {
+		if err := cards.Save(ctx, c.board, &domain.Card{ID: c.id, Title: c.id, List: "todo"}); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+	if _, err := comments.Create(ctx, "board-1", "card-1", &domain.Comment{Author: "alice", Body: "hi"}); err != nil {
+		t.Fatalf("Create comment: %v", err)
+	}
+	if _, err := attachments.Put(ctx, "board-1", "card-1", "a1", strings.NewReader("data")); err != nil {
+		t.Fatalf("Put attachment: %v", err)
+	}
+
+	card, _ := cards.Get(ctx, "board-1", "card-1")
+	id, err := cards.Transfer(ctx, "board-1", "board-2", card)
+	if err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+	if id != "card-1" {
+		t.Errorf("id = %s, want card-1", id)
+	}
+	if _, err := cards.Get(ctx, "board-1", "card-1"); err == nil {
+		t.Error("expected card to be removed from the source board")
+	}
+	if got, err := comments.ListByCard(ctx, "board-2", "card-1"); err != nil || len(got) != 1 {
+		t.Errorf("comments on target = %v, %v", got, err)
+	}
+	rc, err := attachments.Open(ctx, "board-2", "card-1", "a1")
+	if err != nil {
+		t.Fatalf("Open attachment: %v", err)
+	}
+	_ = rc.Close()
+
+	// card-2 already exists on board-2, so a new ID is assigned.
+	card, _ = cards.Get(ctx, "board-1", "card-2")
+	id, err = cards.Transfer(ctx, "board-1", "board-2", card)
+	if err != nil {
+		t.Fatalf("Transfer: %v", err)
+	}
+	if id == "card-2" || card.ID != id {
+		t.Errorf("id = %s, card.ID = %s; want a fresh ID", id, card.ID)
+	}
+	if existing, _ := cards.Get(ctx, "board-2", "card-2"); existing == nil || existing.Title != "card-2" {
+		t.Errorf("existing card on board-2 was overwritten: %+v", existing)
+	}
+
+	var notFound *domain.ErrNotFound
+	if _, err := cards.Transfer(ctx, "board-1", "board-2", &domain.Card{ID: "missing"}); !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestStore_SaveAll(t *testing.T) {
+	base := t.TempDir()
+	store := yamlstore.NewStore(base)
+	cards := yamlstore.NewCardRepositoryAdapter(store)
+	ctx := context.Background()
+
+	for _, id := range []string{"card-1", "card-2"} {
+		if err := cards.Save(ctx, "board-1", &domain.Card{ID: id, Title: "old", List: "todo"}); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+
+	err := cards.SaveAll(ctx, []domain.CardWrite{
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-1", Title: "new", List: "done"}},
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-3", Title: "new", List: "todo"}},
+		{BoardID: "board-2", Card: &domain.Card{ID: "card-1", Title: "new", List: "todo"}},
+	})
+	if err != nil {
+		t.Fatalf("SaveAll: %v", err)
+	}
+	for _, ref := range []domain.CardRef{{Board: "board-1", ID: "card-1"}, {Board: "board-1", ID: "card-3"}, {Board: "board-2", ID: "card-1"}} {
+		if got, err := cards.Get(ctx, ref.Board, ref.ID); err != nil || got.Title != "new" {
+			t.Errorf("%v = %v, %v", ref, got, err)
+		}
+	}
+
+	// card-2 cannot be committed because its backup path is taken, so the
+	// already committed card-1 is rolled back and card-4 is never created.
+	cardsDir := filepath.Join(base, "boards", "board-1", "cards")
+	blocker := filepath.Join(cardsDir, "card-2.yaml.bak")
+	if err := os.MkdirAll(filepath.Join(blocker, "x"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	err = cards.SaveAll(ctx, []domain.CardWrite{
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-1", Title: "newer", List: "todo"}},
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-4", Title: "newer", List: "todo"}},
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-2", Title: "newer", List: "todo"}},
+	})
+	if err == nil {
+		t.Fatal("expected SaveAll to fail")
+	}
+	if got, _ := cards.Get(ctx, "board-1", "card-1"); got.Title != "new" {
+		t.Errorf("card-1 title = %q, want new", got.Title)
+	}
+	if got, _ := cards.Get(ctx, "board-1", "card-2"); got.Title != "old" {
+		t.Errorf("card-2 title = %q, want old", got.Title)
+	}
+	if _, err := cards.Get(ctx, "board-1", "card-4"); err == nil {
+		t.Error("expected card-4 not to be created")
+	}
+	if err := os.RemoveAll(blocker); err != nil {
+		t.Fatal(err)
+	}
+	entries, _ := os.ReadDir(cardsDir)
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), ".yaml") {
+			t.Errorf("leftover file %s", e.Name())
+		}
+	}
+}
+
+func TestStore_Recurring(t *testing.T) {
+	store := setupStore(t)
+	repo := yamlstore.NewRecurringRepositoryAdapter(store)
+	ctx := context.Background()
+
+	items, err := repo.ListByBoard(ctx, "board-1")
+	if err != nil {
+		t.Fatalf("ListByBoard: %v", err)
+	}
+	if len(items) != 0 {
+		t.Fatalf("got %d items, want 0", len(items))
+	}
+
+	rc := &domain.RecurringCard{
+		ID:    "monthly-invoice",
+		Rule:  "FREQ=MONTHLY;BYMONTHDAY=1",
+		Start: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC),
+		Title: "Send invoice",
+		List:  "todo",
+	}
+	if err := repo.Save(ctx, "board-1", rc); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	rc.LastRun = rc.Start
+	if err := repo.Save(ctx, "board-1", rc); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	items, err = repo.ListByBoard(ctx, "board-1")
+	if err != nil {
+		t.Fatalf("ListByBoard: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("got %d items, want 1", len(items))
+	}
+	if !items[0].LastRun.Equal(rc.Start) {
+		t.Errorf("LastRun = %v, want %v", items[0].LastRun, rc.Start)
+	}
+}
+
+func TestStore_Views(t *testing.T) {
+	store := setupStore(t)
+	repo := yamlstore.NewViewRepositoryAdapter(store)
+	ctx := context.Background()
+
+	view := &domain.View{
+		ID:     "my-bugs",
+		Name:   "My bugs",
+		Filter: domain.ViewFilter{Labels: []string{"bug"}, UpdatedAfter: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Sort:   "updated_at",
+	}
+	if err := repo.Save(ctx, "board-1", view); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	view.Name = "Open bugs"
+	if err := repo.Save(ctx, "board-1", view); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	views, err := repo.ListByBoard(ctx, "board-1")
+	if err != nil {
+		t.Fatalf("ListByBoard: %v", err)
+	}
+	if len(views) != 1 || views[0].Name != "Open bugs" || !views[0].Filter.UpdatedAfter.Equal(view.Filter.UpdatedAfter) {
+		t.Fatalf("views = %+v", views)
+	}
+
+	if err := repo.Delete(ctx, "board-1", "my-bugs"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	var notFound *domain.ErrNotFound
+	if err := repo.Delete(ctx, "board-1", "my-bugs"); !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestStore_Templates(t *testing.T) {
+	base := t.TempDir()
+	store := yamlstore.NewStore(base)
+	repo := yamlstore.NewTemplateRepositoryAdapter(store)
+	ctx := context.Background()
+
+	if _, err := repo.Get(ctx, "board-1", "bug"); err == nil {
+		t.Fatal("expected not found")
+	}
+
+	dir := filepath.Join(base, "boards", "board-1", "templates")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	content := "name: Bug report\nlabels: [bug]\ntodos:\n  - text: Reproduce\n"
+	if err := os.WriteFile(filepath.Join(dir, "bug.yaml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	templates, err := repo.ListByBoard(ctx, "board-1")
+	if err != nil {
+		t.Fatalf("ListByBoard: %v", err)
+	}
+	if len(templates) != 1 || templates[0].ID != "bug" || templates[0].Name != "Bug report" {
+		t.Errorf("templates = %+v", templates)
+	}
+
+	got, err := repo.Get(ctx, "board-1", "bug")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(got.Todos) != 1 || got.Todos[0].Text != "Reproduce" {
+		t.Errorf("Todos = %+v", got.Todos)
+	}
+}
+
+func TestStore_Templates_RejectsPaths(t *testing.T) {
+	base := t.TempDir()
+	store := yamlstore.NewStore(base)
+	repo := yamlstore.NewTemplateRepositoryAdapter(store)
+	ctx := context.Background()
+
+	if err := os.WriteFile(filepath.Join(base, "secret.yaml"), []byte("name: Secret\ndescription: leaked\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var ve *domain.ErrValidation
+	for _, id := range []string{"../../../secret", "a/b", "..", ""} {
+		if _, err := repo.Get(ctx, "board-1", id); !errors.As(err, &ve) {
+			t.Errorf("Get(%q): expected ErrValidation, got %v", id, err)
+		}
+		if err := repo.Save(ctx, "board-1", &domain.CardTemplate{ID: id, Name: "X"}); !errors.As(err, &ve) {
+			t.Errorf("Save(%q): expected ErrValidation, got %v", id, err)
+		}
+	}
+}
+
+func TestStore_BoardTemplates(t *testing.T) {
+	base := t.TempDir()
+	store := yamlstore.NewStore(base)
+	repo := yamlstore.NewBoardTemplateRepositoryAdapter(store)
+	ctx := context.Background()
+
+	dir := filepath.Join(base, "board-templates")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	content := "name: Release\nlists:\n  - id: todo\n    name: Todo\n"
+	if err := os.WriteFile(filepath.Join(dir, "release.yaml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	templates, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(templates) != 1 || templates[0].ID != "release" || len(templates[0].Lists) != 1 {
+		t.Errorf("templates = %+v", templates)
+	}
+
+	var notFound *domain.ErrNotFound
+	if _, err := repo.Get(ctx, "missing"); !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(base, "secret.yaml"), []byte("name: Secret\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var ve *domain.ErrValidation
+	if _, err := repo.Get(ctx, "../secret"); !errors.As(err, &ve) {
+		t.Errorf("Get(../secret): expected ErrValidation, got %v", err)
+	}
+
+	// Board templates live outside boards/ and must not show up as boards.
+	boards, err := store.List(ctx)
+	if err != nil {
+		t.Fatalf("List boards: %v", err)
+	}
+	if len(boards) != 0 {
+		t.Errorf("got %d boards, want 0", len(boards))
+	}
+}
+
+func TestStore_SaveTemplate(t *testing.T) {
+	store := setupStore(t)
+	repo := yamlstore.NewTemplateRepositoryAdapter(store)
+	ctx := context.Background()
+
+	if err := repo.Save(ctx, "board-1", &domain.CardTemplate{ID: "bug", Name: "Bug", Labels: []string{"bug"}}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	got, err := repo.Get(ctx, "board-1", "bug")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Name != "Bug" || len(got.Labels) != 1 {
+		t.Errorf("template = %+v", got)
+	}
+}
+
+func TestStore_Trash_Card(t *testing.T) {
+	store := setupStore(t)
+	cards := yamlstore.NewCardRepositoryAdapter(store)
+	comments := yamlstore.NewCommentRepositoryAdapter(store)
+	trash := yamlstore.NewTrashRepositoryAdapter(store)
+	ctx := context.Background()
+
+	if err := store.Save(ctx, &domain.Board{ID: "board-1", Name: "Board", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}); err != nil {
+		t.Fatalf("Save board: %v", err)
+	}
+	if err := cards.Save(ctx, "board-1", &domain.Card{ID: "card-1", Title: "Doomed", List: "todo"}); err != nil {
+		t.Fatalf("Save card: %v", err)
+	}
+	if _, err := comments.Create(ctx, "board-1", "card-1", &domain.Comment{Body: "hi"}); err != nil {
+		t.Fatalf("Create comment: %v", err)
+	}
+
+	if err := cards.Delete(ctx, "board-1", "card-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	items, err := trash.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(items) != 1 || items[0].Kind != domain.TrashCard || items[0].CardID != "card-1" || items[0].Name != "Doomed" {
+		t.Fatalf("items = %+v", items)
+	}
+
+	// The card's ID is reused before it is restored.
+	if err := cards.Save(ctx, "board-1", &domain.Card{ID: "card-1", Title: "Other", List: "todo"}); err != nil {
+		t.Fatalf("Save card: %v", err)
+	}
+	restored, err := trash.Restore(ctx, items[0].ID)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if restored.CardID == "card-1" {
+		t.Error("restored card kept a taken ID")
+	}
+	card, err := cards.Get(ctx, "board-1", restored.CardID)
+	if err != nil {
+		t.Fatalf("Get restored: %v", err)
+	}
+	if card.Title != "Doomed" || card.ID != restored.CardID {
+		t.Errorf("card = %+v", card)
+	}
+	got, err := comments.ListByCard(ctx, "board-1", restored.CardID)
+	if err != nil || len(got) != 1 {
+		t.Errorf("comments = %v, err = %v", got, err)
+	}
+
+	items, err = trash.List(ctx)
+	if err != nil || len(items) != 0 {
+		t.Errorf("trash after restore = %v, err = %v", items, err)
+	}
+}
+
+func TestStore_Trash_Board(t *testing.T) {
+	store := setupStore(t)
+	cards := yamlstore.NewCardRepositoryAdapter(store)
+	trash := yamlstore.NewTrashRepositoryAdapter(store)
+	ctx := context.Background()
+
+	board := &domain.Board{ID: "board-1", Name: "Board", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}
+	if err := store.Save(ctx, board); err != nil {
+		t.Fatalf("Save board: %v", err)
+	}
+	if err := cards.Save(ctx, "board-1", &domain.Card{ID: "card-1", Title: "Kept", List: "todo"}); err != nil {
+		t.Fatalf("Save card: %v", err)
+	}
+
+	if err := store.Delete(ctx, "board-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if boards, _ := store.List(ctx); len(boards) != 0 {
+		t.Errorf("got %d boards after delete, want 0", len(boards))
+	}
+	items, err := trash.List(ctx)
+	if err != nil || len(items) != 1 || items[0].Kind != domain.TrashBoard || items[0].Name != "Board" {
+		t.Fatalf("items = %+v, err = %v", items, err)
+	}
+
+	// A new board took the ID, so the trashed one cannot be restored over it.
+	if err := store.Save(ctx, board); err != nil {
+		t.Fatalf("Save board: %v", err)
+	}
+	var conflict *domain.ErrConflict
+	if _, err := trash.Restore(ctx, items[0].ID); !errors.As(err, &conflict) {
+		t.Fatalf("expected ErrConflict, got %v", err)
+	}
+	if err := store.Delete(ctx, "board-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := trash.Purge(ctx, items[0].ID); err != nil {
+		t.Fatalf("Purge: %v", err)
+	}
+
+	items, err = trash.List(ctx)
+	if err != nil || len(items) != 1 {
+		t.Fatalf("items = %+v, err = %v", items, err)
+	}
+	if _, err := trash.Restore(ctx, items[0].ID); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if _, err := cards.Get(ctx, "board-1", "card-1"); err == nil {
+		t.Error("expected the purged board's card to stay gone")
+	}
+
+	var notFound *domain.ErrNotFound
+	if err := trash.Purge(ctx, items[0].ID); !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestStore_Trash_RejectsPaths(t *testing.T) {
+	base := t.TempDir()
+	store := yamlstore.NewStore(base)
+	trash := yamlstore.NewTrashRepositoryAdapter(store)
+	ctx := context.Background()
+
+	if err := store.Save(ctx, &domain.Board{ID: "board-1", Name: "Board", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}); err != nil {
+		t.Fatalf("Save board: %v", err)
+	}
+	for _, id := range []string{"..", "../boards", "20260124-103000-0a1b2c3d/..", ""} {
+		var ve *domain.ErrValidation
+		if err := trash.Purge(ctx, id); !errors.As(err, &ve) {
+			t.Errorf("Purge(%q): expected ErrValidation, got %v", id, err)
+		}
+		if _, err := trash.Restore(ctx, id); !errors.As(err, &ve) {
+			t.Errorf("Restore(%q): expected ErrValidation, got %v", id, err)
+		}
+	}
+	if _, err := store.Get(ctx, "board-1"); err != nil {
+		t.Errorf("board after rejected purges: %v", err)
+	}
+}
+
+func TestStore_Card_LegacyOrder(t *testing.T) {
+	base := t.TempDir()
+	store := yamlstore.NewStore(base)
+	adapter := yamlstore.NewCardRepositoryAdapter(store)
+	ctx := context.Background()
+
+	// Cards written before ranks existed only have an integer order.
+	dir := filepath.Join(base, "boards", "board-1", "cards")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for id, order := range map[string]int{"card-a": 10, "card-b": 2, "card-c": 36} {
+		content := "id: " + id + "\ntitle: T\nlist: todo\norder: " + strconv.Itoa(order) + "\n"
+		if err := os.WriteFile(filepath.Join(dir, id+".yaml"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cards, err := adapter.ListByBoard(ctx, "board-1", false)
+	if err != nil {
+		t.Fatalf("ListByBoard: %v", err)
+	}
+	var ids []string
+	for i, c := range cards {
+		ids = append(ids, c.ID)
+		if c.Order != i {
+			t.Errorf("card %s order = %d, want %d", c.ID, c.Order, i)
+		}
+	}
+	if strings.Join(ids, ",") != "card-b,card-a,card-c" {
+		t.Errorf("order = %v, want [card-b card-a card-c]", ids)
+	}
+
+	// Saving stores the rank and drops the legacy order.
+	if err := adapter.Save(ctx, "board-1", &cards[2]); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "card-c.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "rank: "+cards[2].Rank) || strings.Contains(string(data), "order:") {
+		t.Errorf("saved card:\n%s", data)
+	}
+}
+
+func TestStore_Card_Markdown(t *testing.T) {
+	base := t.TempDir()
+	store := yamlstore.NewStore(base)
+	adapter := yamlstore.NewCardRepositoryAdapter(store)
+	trash := yamlstore.NewTrashRepositoryAdapter(store)
+	ctx := context.Background()
+	dir := filepath.Join(base, "boards", "board-1", "cards")
+
+	// A card written before the board switched format stays YAML.
+	if err := store.Save(ctx, &domain.Board{ID: "board-1", Name: "B", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := adapter.Save(ctx, "board-1", &domain.Card{ID: "old", Title: "Old", List: "todo", Rank: "a"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save(ctx, &domain.Board{ID: "board-1", Name: "B", Lists: []domain.List{{ID: "todo", Name: "Todo"}}, CardFormat: domain.CardFormatMarkdown}); err != nil {
+		t.Fatal(err)
+	}
+
+	desc := "# Notes\n\n- first\n- second\n\n---\nafter a rule\n"
+	id, err := adapter.Create(ctx, "board-1", &domain.Card{Title: "New", List: "todo", Rank: "b", Description: desc, Labels: []string{"doc"}})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, id+".md"))
+	if err != nil {
+		t.Fatalf("read markdown card: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "---\n") || !strings.HasSuffix(string(data), "---\n"+desc) ||
+		strings.Contains(string(data), "description:") {
+		t.Errorf("markdown card:\n%s", data)
+	}
+	got, err := adapter.Get(ctx, "board-1", id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Description != desc || got.Title != "New" || len(got.Labels) != 1 {
+		t.Errorf("card = %+v", got)
+	}
+
+	old, _ := adapter.Get(ctx, "board-1", "old")
+	old.Title = "Old, edited"
+	if err := adapter.Save(ctx, "board-1", old); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "old.yaml")); err != nil {
+		t.Errorf("yaml card changed format: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "old.md")); !os.IsNotExist(err) {
+		t.Errorf("old.md exists: %v", err)
+	}
+
+	// A hand-written file may leave out the ID.
+	if err := os.WriteFile(filepath.Join(dir, "hand.md"), []byte("---\r\ntitle: Hand\r\nlist: todo\r\nrank: c\r\n---\r\nBody"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cards, err := adapter.ListByBoard(ctx, "board-1", false)
+	if err != nil {
+		t.Fatalf("ListByBoard: %v", err)
+	}
+	var ids []string
+	for _, c := range cards {
+		ids = append(ids, c.ID)
+	}
+	if strings.Join(ids, ",") != "old,"+id+",hand" {
+		t.Errorf("cards = %v", ids)
+	}
+	if cards[2].Description != "Body" {
+		t.Errorf("hand-written description = %q", cards[2].Description)
+	}
+
+	// Trashing and restoring keeps the format.
+	if err := adapter.Delete(ctx, "board-1", id); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	items, _ := trash.List(ctx)
+	if len(items) != 1 {
+		t.Fatalf("trash = %v", items)
+	}
+	if _, err := trash.Restore(ctx, items[0].ID); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if got, err := adapter.Get(ctx, "board-1", id); err != nil || got.Description != desc {
+		t.Errorf("restored = %+v, %v", got, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, id+".md")); err != nil {
+		t.Errorf("restored card is not markdown: %v", err)
+	}
+}
+
+func TestStore_CardCache(t *testing.T) {
+	base := t.TempDir()
+	store := yamlstore.NewStore(base)
+	adapter := yamlstore.NewCardRepositoryAdapter(store)
+	ctx := context.Background()
+
+	if err := adapter.Save(ctx, "board-1", &domain.Card{ID: "card-1", Title: "aaaa", List: "todo", Labels: []string{"bug"}}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	got, err := adapter.Get(ctx, "board-1", "card-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	// Callers own the cards they get back.
+	got.Labels[0] = "changed"
+	if again, _ := adapter.Get(ctx, "board-1", "card-1"); again.Labels[0] != "bug" {
+		t.Errorf("cached card was modified through a returned copy: %v", again.Labels)
+	}
+
+	// An external edit that keeps the size and timestamp is only seen once
+	// the watcher reports it.
+	path := filepath.Join(base, "boards", "board-1", "cards", "card-1.yaml")
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(strings.Replace(string(data), "aaaa", "bbbb", 1)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := adapter.Get(ctx, "board-1", "card-1"); got.Title != "aaaa" {
+		t.Fatalf("title = %q, expected the cached copy", got.Title)
+	}
+	if err := store.Changed(ctx, "board-1", ""); err != nil {
+		t.Fatalf("Changed: %v", err)
+	}
+	cards, err := adapter.ListByBoard(ctx, "board-1", true)
+	if err != nil || len(cards) != 1 || cards[0].Title != "bbbb" {
+		t.Fatalf("cards = %+v, err = %v", cards, err)
+	}
+
+	// An edit that changes the size is seen right away.
+	if err := os.WriteFile(path, []byte(strings.Replace(string(data), "aaaa", "ccccc", 1)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := adapter.Get(ctx, "board-1", "card-1"); got.Title != "ccccc" {
+		t.Errorf("title = %q, want ccccc", got.Title)
+	}
+}
+
+// BenchmarkStore_ListByBoard lists boards where most cards are archived, as
+// on long-lived boards. "uncached" drops the cache before every call, which
+// is what each call cost before the cache existed.
+func BenchmarkStore_ListByBoard(b *testing.B) {
+	for _, n := range []int{100, 1000, 5000} {
+		store := yamlstore.NewStore(b.TempDir())
+		ctx := context.Background()
+		for i := range n {
+			card := &domain.Card{
+				ID:          fmt.Sprintf("20260101-%04d", i),
+				Title:       fmt.Sprintf("Card %d", i),
+				List:        "todo",
+				Rank:        domain.RankFromOrder(i),
+				Description: "Some description that is a little longer than a title.",
+				Labels:      []string{"bug", "backend"},
+				Todos:       []domain.TodoItem{{ID: "t1", Text: "First"}, {ID: "t2", Text: "Second", Completed: true}},
+				Archived:    i%10 != 0,
+				CreatedAt:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			}
+			if err := store.SaveCard(ctx, "board-1", card); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		b.Run(fmt.Sprintf("cards=%d/uncached", n), func(b *testing.B) {
+			for b.Loop() {
+				_ = store.Changed(ctx, "board-1", "")
+				if _, err := store.ListByBoard(ctx, "board-1", false); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("cards=%d/cached", n), func(b *testing.B) {
+			for b.Loop() {
+				if _, err := store.ListByBoard(ctx, "board-1", false); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestStore_ConcurrentBoards(t *testing.T) {
+	store := setupStore(t)
+	ctx := context.Background()
+
+	for _, id := range []string{"board-a", "board-b"} {
+		if err := store.Save(ctx, &domain.Board{ID: id, Name: id, Lists: []domain.List{{ID: "todo", Name: "Todo"}}}); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+
+	// Transfers in opposite directions lock both boards; they must not deadlock.
+	var wg sync.WaitGroup
+	for i := range 20 {
+		from, to := "board-a", "board-b"
+		if i%2 == 1 {
+			from, to = to, from
+		}
+		wg.Go(func() {
+			card := &domain.Card{Title: "T", List: "todo"}
+			if _, err := store.CreateCard(ctx, from, card); err != nil {
+				t.Errorf("CreateCard: %v", err)
+				return
+			}
+			if _, err := store.TransferCard(ctx, from, to, card); err != nil {
+				t.Errorf("TransferCard: %v", err)
+			}
+			if _, err := store.ListByBoard(ctx, to, true); err != nil {
+				t.Errorf("ListByBoard: %v", err)
+			}
+		})
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("concurrent transfers deadlocked")
+	}
+
+	a, _ := store.ListByBoard(ctx, "board-a", true)
+	b, _ := store.ListByBoard(ctx, "board-b", true)
+	if len(a)+len(b) != 20 {
+		t.Errorf("got %d cards, want 20", len(a)+len(b))
+	}
+}
+
+func TestStore_Migrate(t *testing.T) {
+	base := t.TempDir()
+	store := yamlstore.NewStore(base)
+	adapter := yamlstore.NewCardRepositoryAdapter(store)
+	ctx := context.Background()
+
+	cardsDir := filepath.Join(base, "boards", "board-1", "cards")
+	trashedDir := filepath.Join(base, "trash", "20260125-101500-5e6f7a8b")
+	for _, dir := range []string{cardsDir, trashedDir} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	legacy := "# keep me\nid: legacy\ntitle: T\nlist: todo\norder: 10\n"
+	current := "id: current\ntitle: T\nlist: todo\nrank: c\n"
+	trashed := "---\nid: gone\ntitle: T\nlist: todo\norder: 2\n---\nBody\n"
+	files := map[string]string{
+		filepath.Join(cardsDir, "legacy.yaml"):                 legacy,
+		filepath.Join(cardsDir, "current.yaml"):                current,
+		filepath.Join(trashedDir, "card.md"):                   trashed,
+		filepath.Join(trashedDir, "item.yaml"):                 "kind: card\nboard_id: board-1\ncard_id: gone\n",
+		filepath.Join(base, "boards", "board-1", "board.yaml"): "id: board-1\nname: B\nlists:\n  - id: todo\n    name: Todo\n",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	before, err := adapter.Get(ctx, "board-1", "legacy")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	steps, err := store.Migrate(ctx, 0, true)
+	if err != nil {
+		t.Fatalf("Migrate dry run: %v", err)
+	}
+	want := []string{"boards/board-1/cards/legacy.yaml", "trash/20260125-101500-5e6f7a8b/card.md"}
+	if len(steps) != 1 || steps[0].Version != 1 || strings.Join(steps[0].Files, ",") != strings.Join(want, ",") {
+		t.Fatalf("steps = %+v, want one step changing %v", steps, want)
+	}
+	if data, _ := os.ReadFile(filepath.Join(cardsDir, "legacy.yaml")); string(data) != legacy {
+		t.Errorf("dry run wrote the card:\n%s", data)
+	}
+
+	if _, err := store.Migrate(ctx, 0, false); err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+	data, _ := os.ReadFile(filepath.Join(cardsDir, "legacy.yaml"))
+	if !strings.Contains(string(data), "# keep me") || !strings.Contains(string(data), "rank: "+before.Rank) ||
+		strings.Contains(string(data), "order:") {
+		t.Errorf("migrated card:\n%s", data)
+	}
+	if data, _ := os.ReadFile(filepath.Join(cardsDir, "current.yaml")); string(data) != current {
+		t.Errorf("current card was rewritten:\n%s", data)
+	}
+	data, _ = os.ReadFile(filepath.Join(trashedDir, "card.md"))
+	if !strings.HasSuffix(string(data), "---\nBody\n") || !strings.Contains(string(data), "rank: "+domain.RankFromOrder(2)) {
+		t.Errorf("migrated trashed card:\n%s", data)
+	}
+	after, err := adapter.Get(ctx, "board-1", "legacy")
+	if err != nil || after.Rank != before.Rank {
+		t.Errorf("card after migration = %+v, %v; rank before %q", after, err, before.Rank)
+	}
+
+	if steps, err := store.Migrate(ctx, yamlstore.SchemaVersion, false); err != nil || len(steps) != 0 {
+		t.Errorf("Migrate at the current version = %v, %v", steps, err)
+	}
+	var tooNew *yamlstore.ErrSchemaTooNew
+	if _, err := store.Migrate(ctx, yamlstore.SchemaVersion+1, false); !errors.As(err, &tooNew) {
+		t.Errorf("Migrate from a newer version: expected ErrSchemaTooNew, got %v", err)
+	}
+}
+
+func TestStore_ResolveIDConflicts(t *testing.T) {
+	base := t.TempDir()
+	store := yamlstore.NewStore(base)
+	adapter := yamlstore.NewCardRepositoryAdapter(store)
+	ctx := context.Background()
+
+	board := &domain.Board{ID: "board-1", Name: "B", Lists: []domain.List{{ID: "todo", Name: "Todo"}}, IDFormat: domain.CardIDPrefix, IDPrefix: "PROJ"}
+	if err := store.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(base, "boards", "board-1", "cards")
+	// What git leaves after merging two branches that both created PROJ-3, and
+	// one that created PROJ-4 as YAML with one that created it as Markdown.
+	// PROJ-7 and PROJ-8 are single cards both branches edited, in the diff3
+	// and the merge style: they are not split.
+	files := map[string]string{
+		"PROJ-3.yaml": "id: PROJ-3\n<<<<<<< HEAD\ntitle: Ours\ncreated_at: 2026-01-24T10:00:00Z\n=======\ntitle: Theirs\ncreated_at: 2026-01-24T11:00:00Z\n>>>>>>> feature\nlist: todo\nrank: a\n",
+		"PROJ-7.yaml": "id: PROJ-7\n<<<<<<< HEAD\ntitle: Ours\n||||||| base\ntitle: Base\n=======\ntitle: Theirs\n>>>>>>> feature\nlist: todo\nrank: d\ncreated_at: 2026-01-20T10:00:00Z\n",
+		"PROJ-8.yaml": "id: PROJ-8\n<<<<<<< HEAD\ntitle: Ours\n=======\ntitle: Theirs\n>>>>>>> feature\nlist: todo\nrank: e\ncreated_at: 2026-01-20T10:00:00Z\n",
+		"PROJ-4.yaml": "id: PROJ-4\ntitle: Yaml\nlist: todo\nrank: b\n",
+		"PROJ-4.md":   "---\nid: PROJ-4\ntitle: Markdown\nlist: todo\nrank: c\n---\nBody\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	counter := filepath.Join(base, "boards", "board-1", "id-counter")
+	if err := os.WriteFile(counter, []byte("<<<<<<< HEAD\n3\n=======\n4\n>>>>>>> feature\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	dry, err := store.ResolveIDConflicts(ctx, "board-1", true)
+	if err != nil {
+		t.Fatalf("ResolveIDConflicts dry run: %v", err)
+	}
+	want := &yamlstore.IDConflictResult{
+		Reassignments: []yamlstore.IDReassignment{
+			{BoardID: "board-1", OldID: "PROJ-3", NewID: "PROJ-9", File: "boards/board-1/cards/PROJ-3.yaml"},
+			{BoardID: "board-1", OldID: "PROJ-4", NewID: "PROJ-10", File: "boards/board-1/cards/PROJ-4.md"},
+		},
+		Unresolved: []string{"boards/board-1/cards/PROJ-7.yaml", "boards/board-1/cards/PROJ-8.yaml"},
+	}
+	if fmt.Sprint(dry) != fmt.Sprint(want) {
+		t.Errorf("dry run = %+v, want %+v", dry, want)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "PROJ-3.yaml")); string(data) != files["PROJ-3.yaml"] {
+		t.Errorf("dry run rewrote the conflicted file:\n%s", data)
+	}
+
+	got, err := store.ResolveIDConflicts(ctx, "board-1", false)
+	if err != nil {
+		t.Fatalf("ResolveIDConflicts: %v", err)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("reassignments = %+v, want %+v", got, want)
+	}
+	for _, name := range []string{"PROJ-7.yaml", "PROJ-8.yaml"} {
+		if data, _ := os.ReadFile(filepath.Join(dir, name)); string(data) != files[name] {
+			t.Errorf("%s was changed:\n%s", name, data)
+		}
+	}
+	titles := map[string]string{"PROJ-3": "Ours", "PROJ-4": "Yaml", "PROJ-9": "Theirs", "PROJ-10": "Markdown"}
+	cards, err := adapter.ListByBoard(ctx, "board-1", true)
+	if err != nil {
+		t.Fatalf("ListByBoard: %v", err)
+	}
+	if len(cards) != len(titles) {
+		t.Errorf("got %d cards, want %d", len(cards), len(titles))
+	}
+	for _, c := range cards {
+		if titles[c.ID] != c.Title {
+			t.Errorf("card %s = %q, want %q", c.ID, c.Title, titles[c.ID])
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "PROJ-10.md")); err != nil {
+		t.Errorf("reassigned Markdown card: %v", err)
+	}
+	if id, err := adapter.Create(ctx, "board-1", &domain.Card{Title: "Next", List: "todo"}); err != nil || id != "PROJ-11" {
+		t.Errorf("Create after resolving = %q, %v; want PROJ-11", id, err)
+	}
+
+	again, err := store.ResolveIDConflicts(ctx, "board-1", false)
+	if err != nil || len(again.Reassignments) != 0 || len(again.Unresolved) != 2 {
+		t.Errorf("second run = %+v, %v", again, err)
+	}
+}
+
+func TestStore_FindIDReferences(t *testing.T) {
+	store := yamlstore.NewStore(t.TempDir())
+	adapter := yamlstore.NewCardRepositoryAdapter(store)
+	ctx := context.Background()
+
+	for _, id := range []string{"board-1", "board-2"} {
+		board := &domain.Board{ID: id, Name: id, Lists: []domain.List{{ID: "todo", Name: "Todo"}}}
+		if err := store.Save(ctx, board); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cards := []struct {
+		board string
+		card  domain.Card
+	}{
+		{"board-1", domain.Card{ID: "PROJ-3", Title: "Renamed", List: "todo"}},
+		{"board-1", domain.Card{ID: "PROJ-5", Title: "Child", List: "todo", Parent: "PROJ-3"}},
+		{"board-1", domain.Card{ID: "PROJ-6", Title: "Blocked", List: "todo", BlockedBy: []domain.CardRef{{ID: "PROJ-3"}, {Board: "board-1", ID: "PROJ-4"}}}},
+		{"board-2", domain.Card{ID: "PROJ-3", Title: "Other board", List: "todo", BlockedBy: []domain.CardRef{{Board: "board-1", ID: "PROJ-3"}}}},
+		{"board-2", domain.Card{ID: "PROJ-7", Title: "Same ID elsewhere", List: "todo", Parent: "PROJ-3"}},
+	}
+	for _, c := range cards {
+		if err := adapter.Save(ctx, c.board, &c.card); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := store.FindIDReferences(ctx, []yamlstore.IDReassignment{{BoardID: "board-1", OldID: "PROJ-3", NewID: "PROJ-8"}})
+	if err != nil {
+		t.Fatalf("FindIDReferences: %v", err)
+	}
+	ref := domain.CardRef{Board: "board-1", ID: "PROJ-3"}
+	want := []yamlstore.IDReference{
+		{BoardID: "board-1", CardID: "PROJ-5", Field: "parent", Ref: ref},
+		{BoardID: "board-1", CardID: "PROJ-6", Field: "blocked_by", Ref: ref},
+		{BoardID: "board-2", CardID: "PROJ-3", Field: "blocked_by", Ref: ref},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("references = %+v, want %+v", got, want)
+	}
+
+	if got, err := store.FindIDReferences(ctx, nil); err != nil || len(got) != 0 {
+		t.Errorf("no reassignments = %v, %v", got, err)
+	}
+}
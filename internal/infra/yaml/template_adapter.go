@@ -0,0 +1,28 @@
+package yaml
+
+import (
+	"context"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// TemplateRepositoryAdapter adapts Store to satisfy domain.TemplateRepository interface.
+type TemplateRepositoryAdapter struct {
+	store *Store
+}
+
+func NewTemplateRepositoryAdapter(store *Store) *TemplateRepositoryAdapter {
+	return &TemplateRepositoryAdapter{store: store}
+}
+
+func (a *TemplateRepositoryAdapter) ListByBoard(ctx context.Context, boardID string) ([]domain.CardTemplate, error) {
+	return a.store.ListTemplates(ctx, boardID)
+}
+
+func (a *TemplateRepositoryAdapter) Get(ctx context.Context, boardID, templateID string) (*domain.CardTemplate, error) {
+	return a.store.GetTemplate(ctx, boardID, templateID)
+}
+
+func (a *TemplateRepositoryAdapter) Save(ctx context.Context, boardID string, t *domain.CardTemplate) error {
+	return a.store.SaveTemplate(ctx, boardID, t)
+}
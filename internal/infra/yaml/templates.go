@@ -0,0 +1,107 @@
+package yaml
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func (s *Store) templatesDir(boardID string) string {
+	return filepath.Join(s.boardDir(boardID), "templates")
+}
+
+func (s *Store) templateFile(boardID, templateID string) string {
+	return filepath.Join(s.templatesDir(boardID), templateID+".yaml")
+}
+
+// TemplateRepository implementation
+
+func (s *Store) ListTemplates(_ context.Context, boardID string) ([]domain.CardTemplate, error) {
+	defer s.rlockBoard(boardID)()
+
+	entries, err := os.ReadDir(s.templatesDir(boardID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []domain.CardTemplate{}, nil
+		}
+		return nil, fmt.Errorf("read templates dir: %w", err)
+	}
+
+	templates := []domain.CardTemplate{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+		id := strings.TrimSuffix(entry.Name(), ".yaml")
+		if domain.ValidateTemplateID(id) != nil {
+			continue
+		}
+		t, err := s.readTemplate(boardID, id)
+		if err != nil {
+			continue
+		}
+		templates = append(templates, *t)
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].ID < templates[j].ID
+	})
+	return templates, nil
+}
+
+func (s *Store) GetTemplate(_ context.Context, boardID, templateID string) (*domain.CardTemplate, error) {
+	if err := domain.ValidateTemplateID(templateID); err != nil {
+		return nil, err
+	}
+	defer s.rlockBoard(boardID)()
+
+	return s.readTemplate(boardID, templateID)
+}
+
+func (s *Store) SaveTemplate(_ context.Context, boardID string, t *domain.CardTemplate) error {
+	if err := domain.ValidateTemplateID(t.ID); err != nil {
+		return err
+	}
+	defer s.lockBoard(boardID)()
+
+	data, err := yamlv3.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("marshal template: %w", err)
+	}
+
+	if err := os.MkdirAll(s.templatesDir(boardID), 0o755); err != nil {
+		return fmt.Errorf("create templates dir: %w", err)
+	}
+
+	if err := os.WriteFile(s.templateFile(boardID, t.ID), data, 0o644); err != nil {
+		return fmt.Errorf("write template file: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) readTemplate(boardID, templateID string) (*domain.CardTemplate, error) {
+	data, err := os.ReadFile(s.templateFile(boardID, templateID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, &domain.ErrNotFound{Resource: "template", ID: templateID}
+		}
+		return nil, fmt.Errorf("read template file: %w", err)
+	}
+
+	var t domain.CardTemplate
+	if err := yamlv3.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("unmarshal template: %w", err)
+	}
+	t.ID = templateID
+	if t.Name == "" {
+		t.Name = templateID
+	}
+	return &t, nil
+}
@@ -0,0 +1,473 @@
+package yaml
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// A trashed item is a directory under .tasks/trash holding item.yaml and the
+// files that were moved out of the board: the whole board directory as board/,
+// or a card as card.yaml (card.md for a Markdown card), comments.yaml and
+// attachments/.
+
+func (s *Store) trashDir() string {
+	return filepath.Join(s.basePath, "trash")
+}
+
+func (s *Store) trashItemDir(itemID string) string {
+	return filepath.Join(s.trashDir(), itemID)
+}
+
+// checkedTrashItemDir returns the directory of the trash item with an ID
+// taken from outside the store. The ID must be in the generated form, and
+// the directory must lie directly under the trash directory.
+func (s *Store) checkedTrashItemDir(itemID string) (string, error) {
+	if err := domain.ValidateTrashItemID(itemID); err != nil {
+		return "", err
+	}
+	dir := filepath.Clean(s.trashItemDir(itemID))
+	if filepath.Dir(dir) != filepath.Clean(s.trashDir()) {
+		return "", &domain.ErrValidation{Field: "id", Message: "is not a trash item id"}
+	}
+	return dir, nil
+}
+
+func (s *Store) trashItemFile(itemID string) string {
+	return filepath.Join(s.trashItemDir(itemID), "item.yaml")
+}
+
+// TrashRepository implementation
+
+// ListTrash returns the trashed items, most recently deleted first.
+func (s *Store) ListTrash(_ context.Context) ([]domain.TrashItem, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.trashMu.Lock()
+	defer s.trashMu.Unlock()
+
+	entries, err := os.ReadDir(s.trashDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []domain.TrashItem{}, nil
+		}
+		return nil, fmt.Errorf("read trash dir: %w", err)
+	}
+
+	items := []domain.TrashItem{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		item, err := s.readTrashItem(entry.Name())
+		if err != nil {
+			continue
+		}
+		items = append(items, *item)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+	return items, nil
+}
+
+// RestoreTrash moves a trashed item back into its board. A board is not
+// restored over a board with the same ID, and a card needs its board to exist.
+func (s *Store) RestoreTrash(_ context.Context, itemID string) (*domain.TrashItem, error) {
+	dir, err := s.checkedTrashItemDir(itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, err := s.readTrashItem(itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch item.Kind {
+	case domain.TrashBoard:
+		if _, err := os.Stat(s.boardDir(item.BoardID)); err == nil {
+			return nil, &domain.ErrConflict{Resource: "board", ID: item.BoardID}
+		}
+		if err := moveIfExists(filepath.Join(dir, "board"), s.boardDir(item.BoardID)); err != nil {
+			return nil, fmt.Errorf("restore board dir: %w", err)
+		}
+	case domain.TrashCard:
+		cardID, err := s.restoreCardLocked(dir, item)
+		if err != nil {
+			return nil, err
+		}
+		item.CardID = cardID
+	default:
+		return nil, fmt.Errorf("unknown trash item kind %q", item.Kind)
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("remove trash item: %w", err)
+	}
+	return item, nil
+}
+
+func (s *Store) restoreCardLocked(dir string, item *domain.TrashItem) (string, error) {
+	if _, err := s.readBoard(item.BoardID); err != nil {
+		return "", err
+	}
+
+	trashed := trashedCardFile(dir)
+	card, err := readTrashedCard(trashed, item)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(s.cardFile(item.BoardID, card.ID)); err == nil {
+		id, err := s.nextIDLocked(item.BoardID)
+		if err != nil {
+			return "", err
+		}
+		card.ID = id
+	}
+
+	if err := os.MkdirAll(s.cardsDir(item.BoardID), 0o755); err != nil {
+		return "", fmt.Errorf("create cards dir: %w", err)
+	}
+	if err := s.writeCardFile(s.cardFileAs(item.BoardID, card.ID, filepath.Ext(trashed)), card); err != nil {
+		return "", err
+	}
+
+	if err := s.restoreCardFilesLocked(dir, domain.CardRef{Board: item.BoardID, ID: card.ID}); err != nil {
+		return "", err
+	}
+	return card.ID, nil
+}
+
+// trashedCardFile returns the card file of a trashed card's directory.
+func trashedCardFile(dir string) string {
+	path := filepath.Join(dir, "card"+yamlExt)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		path = filepath.Join(dir, "card"+markdownExt)
+	}
+	return path
+}
+
+// readTrashedCard reads a trashed card, which takes its ID from the item.
+func readTrashedCard(path string, item *domain.TrashItem) (*domain.Card, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read trashed card: %w", err)
+	}
+	card, err := decodeCard(path, data)
+	if err != nil {
+		return nil, err
+	}
+	card.ID = item.CardID
+	return card, nil
+}
+
+func (s *Store) PurgeTrash(_ context.Context, itemID string) error {
+	dir, err := s.checkedTrashItemDir(itemID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.trashMu.Lock()
+	defer s.trashMu.Unlock()
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return &domain.ErrNotFound{Resource: "trash item", ID: itemID}
+	}
+	return os.RemoveAll(dir)
+}
+
+func (s *Store) readTrashItem(itemID string) (*domain.TrashItem, error) {
+	data, err := os.ReadFile(s.trashItemFile(itemID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, &domain.ErrNotFound{Resource: "trash item", ID: itemID}
+		}
+		return nil, fmt.Errorf("read trash item: %w", err)
+	}
+
+	var item domain.TrashItem
+	if err := yamlv3.Unmarshal(data, &item); err != nil {
+		return nil, fmt.Errorf("unmarshal trash item: %w", err)
+	}
+	item.ID = itemID
+	return &item, nil
+}
+
+// createTrashItemLocked writes item.yaml for a new trash entry and returns
+// the entry's directory. The caller holds s.mu and, unless it holds s.mu for
+// writing, s.trashMu. The ID starts with the deletion time so that a
+// listing of the trash directory reads in order.
+func (s *Store) createTrashItemLocked(item *domain.TrashItem) (string, error) {
+	for {
+		b := make([]byte, 4)
+		if _, err := rand.Read(b); err != nil {
+			return "", fmt.Errorf("generate trash id: %w", err)
+		}
+		item.ID = item.DeletedAt.UTC().Format("20060102-150405") + "-" + hex.EncodeToString(b)
+		if _, err := os.Stat(s.trashItemDir(item.ID)); os.IsNotExist(err) {
+			break
+		}
+	}
+
+	dir := s.trashItemDir(item.ID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("create trash dir: %w", err)
+	}
+	data, err := yamlv3.Marshal(item)
+	if err != nil {
+		return "", fmt.Errorf("marshal trash item: %w", err)
+	}
+	if err := os.WriteFile(s.trashItemFile(item.ID), data, 0o644); err != nil {
+		return "", fmt.Errorf("write trash item: %w", err)
+	}
+	return dir, nil
+}
+
+func (s *Store) trashBoardLocked(boardID string) error {
+	item := &domain.TrashItem{Kind: domain.TrashBoard, BoardID: boardID, Name: boardID, DeletedAt: time.Now()}
+	if board, err := s.readBoard(boardID); err == nil {
+		item.Name = board.Name
+	}
+
+	dir, err := s.createTrashItemLocked(item)
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(s.boardDir(boardID), filepath.Join(dir, "board")); err != nil {
+		_ = os.RemoveAll(dir)
+		return fmt.Errorf("move board to trash: %w", err)
+	}
+	s.cards.removeDir(s.boardDir(boardID))
+	return nil
+}
+
+// trashCardLocked moves a card with its files to a new trash item and returns
+// the item's directory. The caller holds the board's lock.
+func (s *Store) trashCardLocked(boardID, cardID string) (string, error) {
+	item := &domain.TrashItem{Kind: domain.TrashCard, BoardID: boardID, CardID: cardID, DeletedAt: time.Now()}
+	if card, err := s.readCard(boardID, cardID); err == nil {
+		item.Name = card.Title
+	}
+
+	s.trashMu.Lock()
+	defer s.trashMu.Unlock()
+	dir, err := s.createTrashItemLocked(item)
+	if err != nil {
+		return "", err
+	}
+	path := s.cardFile(boardID, cardID)
+	trashed := filepath.Join(dir, "card"+filepath.Ext(path))
+	if err := os.Rename(path, trashed); err != nil {
+		_ = os.RemoveAll(dir)
+		return "", fmt.Errorf("move card to trash: %w", err)
+	}
+	s.cards.remove(path)
+	if err := s.trashCardFilesLocked(domain.CardRef{Board: boardID, ID: cardID}, dir); err != nil {
+		_ = os.Rename(trashed, path)
+		_ = os.RemoveAll(dir)
+		return "", err
+	}
+	return dir, nil
+}
+
+// untrashCardLocked undoes trashCardLocked, which returned dir, while the
+// caller still holds the board's lock.
+func (s *Store) untrashCardLocked(boardID, cardID, dir string) {
+	s.trashMu.Lock()
+	defer s.trashMu.Unlock()
+
+	trashed := trashedCardFile(dir)
+	path := s.cardFileAs(boardID, cardID, filepath.Ext(trashed))
+	_ = s.restoreCardFilesLocked(dir, domain.CardRef{Board: boardID, ID: cardID})
+	_ = os.Rename(trashed, path)
+	s.cards.remove(path)
+	_ = os.RemoveAll(dir)
+}
+
+// TrashCopier implementation
+
+// ExportTrash returns every trashed item with the board and cards it holds.
+// The comments and attachments of trashed cards are not included.
+func (s *Store) ExportTrash(_ context.Context) ([]domain.TrashEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.trashMu.Lock()
+	defer s.trashMu.Unlock()
+
+	dirEntries, err := os.ReadDir(s.trashDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []domain.TrashEntry{}, nil
+		}
+		return nil, fmt.Errorf("read trash dir: %w", err)
+	}
+
+	entries := []domain.TrashEntry{}
+	for _, e := range dirEntries {
+		if !e.IsDir() {
+			continue
+		}
+		item, err := s.readTrashItem(e.Name())
+		if err != nil {
+			continue
+		}
+		entry := domain.TrashEntry{Item: *item}
+		dir := s.trashItemDir(item.ID)
+		switch item.Kind {
+		case domain.TrashBoard:
+			entry.Board, entry.Cards, err = readTrashedBoard(filepath.Join(dir, "board"))
+		case domain.TrashCard:
+			var card *domain.Card
+			if card, err = readTrashedCard(trashedCardFile(dir), item); err == nil {
+				entry.Cards = []domain.Card{*card}
+			}
+		default:
+			err = fmt.Errorf("unknown trash item kind %q", item.Kind)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("trash item %s: %w", item.ID, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// readTrashedBoard reads the board directory of a trashed board.
+func readTrashedBoard(dir string) (*domain.Board, []domain.Card, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "board.yaml"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("read trashed board: %w", err)
+	}
+	var board domain.Board
+	if err := yamlv3.Unmarshal(data, &board); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal board: %w", err)
+	}
+
+	cardsDir := filepath.Join(dir, "cards")
+	files, err := os.ReadDir(cardsDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, fmt.Errorf("read cards dir: %w", err)
+	}
+	var cards []domain.Card
+	for _, f := range files {
+		if f.IsDir() || !isCardFileName(f.Name()) {
+			continue
+		}
+		path := filepath.Join(cardsDir, f.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read card file: %w", err)
+		}
+		card, err := decodeClaim(path, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		cards = append(cards, *card)
+	}
+	return &board, cards, nil
+}
+
+// ImportTrash writes entries into the trash as ExportTrash reads them, in
+// the card format of their boards.
+func (s *Store) ImportTrash(_ context.Context, entries []domain.TrashEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range entries {
+		if err := s.importTrashEntryLocked(&entries[i]); err != nil {
+			return fmt.Errorf("trash item %s: %w", entries[i].Item.ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *Store) importTrashEntryLocked(entry *domain.TrashEntry) error {
+	item := entry.Item
+	dir, err := s.checkedTrashItemDir(item.ID)
+	if err != nil {
+		return err
+	}
+	// The directory may already hold the item's comments and attachments,
+	// kept there by the storage the trash is copied from.
+	if _, err := os.Stat(s.trashItemFile(item.ID)); err == nil {
+		return &domain.ErrConflict{Resource: "trash item", ID: item.ID}
+	}
+
+	var cardsDir, ext string
+	switch item.Kind {
+	case domain.TrashBoard:
+		if entry.Board == nil {
+			return fmt.Errorf("trashed board without its board")
+		}
+		boardDir := filepath.Join(dir, "board")
+		cardsDir = filepath.Join(boardDir, "cards")
+		if err := os.MkdirAll(cardsDir, 0o755); err != nil {
+			return fmt.Errorf("create trash dir: %w", err)
+		}
+		data, err := yamlv3.Marshal(entry.Board)
+		if err != nil {
+			return fmt.Errorf("marshal board: %w", err)
+		}
+		if err := os.WriteFile(filepath.Join(boardDir, "board.yaml"), data, 0o644); err != nil {
+			return fmt.Errorf("write board file: %w", err)
+		}
+		ext = cardExt(entry.Board)
+	case domain.TrashCard:
+		if len(entry.Cards) != 1 {
+			return fmt.Errorf("trashed card with %d cards", len(entry.Cards))
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("create trash dir: %w", err)
+		}
+		board, err := s.readBoard(item.BoardID)
+		if err != nil {
+			board = nil
+		}
+		ext = cardExt(board)
+	default:
+		return fmt.Errorf("unknown trash item kind %q", item.Kind)
+	}
+
+	for i := range entry.Cards {
+		card := &entry.Cards[i]
+		path := filepath.Join(dir, "card"+ext)
+		if cardsDir != "" {
+			path = filepath.Join(cardsDir, card.ID+ext)
+		}
+		if err := s.writeCardFile(path, card); err != nil {
+			return err
+		}
+	}
+
+	data, err := yamlv3.Marshal(&item)
+	if err != nil {
+		return fmt.Errorf("marshal trash item: %w", err)
+	}
+	if err := os.WriteFile(s.trashItemFile(item.ID), data, 0o644); err != nil {
+		return fmt.Errorf("write trash item: %w", err)
+	}
+	return nil
+}
+
+// cardExt returns the extension of new card files on board, which may be nil.
+func cardExt(board *domain.Board) string {
+	if board != nil && board.CardFormat == domain.CardFormatMarkdown {
+		return markdownExt
+	}
+	return yamlExt
+}
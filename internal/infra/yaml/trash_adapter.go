@@ -0,0 +1,28 @@
+package yaml
+
+import (
+	"context"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// TrashRepositoryAdapter adapts Store to satisfy domain.TrashRepository interface.
+type TrashRepositoryAdapter struct {
+	store *Store
+}
+
+func NewTrashRepositoryAdapter(store *Store) *TrashRepositoryAdapter {
+	return &TrashRepositoryAdapter{store: store}
+}
+
+func (a *TrashRepositoryAdapter) List(ctx context.Context) ([]domain.TrashItem, error) {
+	return a.store.ListTrash(ctx)
+}
+
+func (a *TrashRepositoryAdapter) Restore(ctx context.Context, itemID string) (*domain.TrashItem, error) {
+	return a.store.RestoreTrash(ctx, itemID)
+}
+
+func (a *TrashRepositoryAdapter) Purge(ctx context.Context, itemID string) error {
+	return a.store.PurgeTrash(ctx, itemID)
+}
@@ -0,0 +1,28 @@
+package yaml
+
+import (
+	"context"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// ViewRepositoryAdapter adapts Store to satisfy domain.ViewRepository interface.
+type ViewRepositoryAdapter struct {
+	store *Store
+}
+
+func NewViewRepositoryAdapter(store *Store) *ViewRepositoryAdapter {
+	return &ViewRepositoryAdapter{store: store}
+}
+
+func (a *ViewRepositoryAdapter) ListByBoard(ctx context.Context, boardID string) ([]domain.View, error) {
+	return a.store.ListViews(ctx, boardID)
+}
+
+func (a *ViewRepositoryAdapter) Save(ctx context.Context, boardID string, view *domain.View) error {
+	return a.store.SaveView(ctx, boardID, view)
+}
+
+func (a *ViewRepositoryAdapter) Delete(ctx context.Context, boardID, viewID string) error {
+	return a.store.DeleteView(ctx, boardID, viewID)
+}
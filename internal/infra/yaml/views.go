@@ -0,0 +1,95 @@
+package yaml
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+func (s *Store) viewsFile(boardID string) string {
+	return filepath.Join(s.boardDir(boardID), "views.yaml")
+}
+
+// ViewRepository implementation
+
+func (s *Store) ListViews(_ context.Context, boardID string) ([]domain.View, error) {
+	defer s.rlockBoard(boardID)()
+
+	return s.readViews(boardID)
+}
+
+// SaveView replaces the view with the same ID, or appends it.
+func (s *Store) SaveView(_ context.Context, boardID string, view *domain.View) error {
+	defer s.lockBoard(boardID)()
+
+	views, err := s.readViews(boardID)
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+	for i := range views {
+		if views[i].ID == view.ID {
+			views[i] = *view
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		views = append(views, *view)
+	}
+	return s.writeViews(boardID, views)
+}
+
+func (s *Store) DeleteView(_ context.Context, boardID, viewID string) error {
+	defer s.lockBoard(boardID)()
+
+	views, err := s.readViews(boardID)
+	if err != nil {
+		return err
+	}
+
+	for i := range views {
+		if views[i].ID == viewID {
+			return s.writeViews(boardID, append(views[:i], views[i+1:]...))
+		}
+	}
+	return &domain.ErrNotFound{Resource: "view", ID: viewID}
+}
+
+func (s *Store) readViews(boardID string) ([]domain.View, error) {
+	data, err := os.ReadFile(s.viewsFile(boardID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []domain.View{}, nil
+		}
+		return nil, fmt.Errorf("read views file: %w", err)
+	}
+
+	views := []domain.View{}
+	if err := yamlv3.Unmarshal(data, &views); err != nil {
+		return nil, fmt.Errorf("unmarshal views: %w", err)
+	}
+	return views, nil
+}
+
+func (s *Store) writeViews(boardID string, views []domain.View) error {
+	data, err := yamlv3.Marshal(views)
+	if err != nil {
+		return fmt.Errorf("marshal views: %w", err)
+	}
+
+	if err := os.MkdirAll(s.boardDir(boardID), 0o755); err != nil {
+		return fmt.Errorf("create board dir: %w", err)
+	}
+
+	if err := os.WriteFile(s.viewsFile(boardID), data, 0o644); err != nil {
+		return fmt.Errorf("write views file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// AttachmentUseCase keeps the attachment list of a card through cardUC, so
+// that it holds the same board lock as the card's other edits.
+type AttachmentUseCase struct {
+	attachmentRepo domain.AttachmentRepository
+	cardUC         *CardUseCase
+	maxSize        int64
+}
+
+// NewAttachmentUseCase creates an AttachmentUseCase that rejects files larger than maxSize bytes.
+func NewAttachmentUseCase(attachmentRepo domain.AttachmentRepository, cardUC *CardUseCase, maxSize int64) *AttachmentUseCase {
+	return &AttachmentUseCase{attachmentRepo: attachmentRepo, cardUC: cardUC, maxSize: maxSize}
+}
+
+func (uc *AttachmentUseCase) MaxSize() int64 {
+	return uc.maxSize
+}
+
+func (uc *AttachmentUseCase) Upload(ctx context.Context, boardID, cardID, filename, contentType string, r io.Reader) (*domain.Attachment, error) {
+	filename = filepath.Base(filepath.Clean("/" + filename))
+	if filename == "/" || filename == "." {
+		return nil, &domain.ErrValidation{Field: "filename", Message: "is required"}
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	if _, err := uc.cardUC.cardRepo.Get(ctx, boardID, cardID); err != nil {
+		return nil, err
+	}
+
+	id, err := newAttachmentID()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	size, err := uc.attachmentRepo.Put(ctx, boardID, cardID, id, io.TeeReader(io.LimitReader(r, uc.maxSize+1), hash))
+	if err != nil {
+		return nil, err
+	}
+	if size > uc.maxSize {
+		_ = uc.attachmentRepo.Delete(ctx, boardID, cardID, id)
+		return nil, &domain.ErrValidation{
+			Field:   "file",
+			Message: fmt.Sprintf("exceeds the maximum size of %d bytes", uc.maxSize),
+		}
+	}
+
+	attachment := domain.Attachment{
+		ID:          id,
+		Filename:    filename,
+		Size:        size,
+		ContentType: contentType,
+		SHA256:      hex.EncodeToString(hash.Sum(nil)),
+		CreatedAt:   time.Now(),
+	}
+	// The file is written without the board lock; only adding it to the
+	// card holds it.
+	_, err = uc.cardUC.modifyCard(ctx, boardID, cardID, func(card *domain.Card) error {
+		card.Attachments = append(card.Attachments, attachment)
+		return nil
+	})
+	if err != nil {
+		_ = uc.attachmentRepo.Delete(ctx, boardID, cardID, id)
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// Open returns the attachment metadata and its content. The caller must close the reader.
+func (uc *AttachmentUseCase) Open(ctx context.Context, boardID, cardID, attachmentID string) (*domain.Attachment, io.ReadCloser, error) {
+	card, err := uc.cardUC.cardRepo.Get(ctx, boardID, cardID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idx := card.FindAttachment(attachmentID)
+	if idx < 0 {
+		return nil, nil, &domain.ErrNotFound{Resource: "attachment", ID: attachmentID}
+	}
+
+	rc, err := uc.attachmentRepo.Open(ctx, boardID, cardID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &card.Attachments[idx], rc, nil
+}
+
+func (uc *AttachmentUseCase) Delete(ctx context.Context, boardID, cardID, attachmentID string) error {
+	_, err := uc.cardUC.modifyCard(ctx, boardID, cardID, func(card *domain.Card) error {
+		idx := card.FindAttachment(attachmentID)
+		if idx < 0 {
+			return &domain.ErrNotFound{Resource: "attachment", ID: attachmentID}
+		}
+		card.Attachments = append(card.Attachments[:idx], card.Attachments[idx+1:]...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// The metadata is already gone, so a missing file is not an error.
+	if err := uc.attachmentRepo.Delete(ctx, boardID, cardID, attachmentID); err != nil {
+		var notFound *domain.ErrNotFound
+		if !errors.As(err, &notFound) {
+			return err
+		}
+	}
+	return nil
+}
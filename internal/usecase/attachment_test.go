@@ -0,0 +1,182 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type mockAttachmentRepo struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func newMockAttachmentRepo() *mockAttachmentRepo {
+	return &mockAttachmentRepo{files: map[string][]byte{}}
+}
+
+func (m *mockAttachmentRepo) Put(_ context.Context, _, _, attachmentID string, r io.Reader) (int64, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files[attachmentID] = data
+	return int64(len(data)), nil
+}
+
+func (m *mockAttachmentRepo) Open(_ context.Context, _, _, attachmentID string) (io.ReadCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.files[attachmentID]
+	if !ok {
+		return nil, &domain.ErrNotFound{Resource: "attachment", ID: attachmentID}
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *mockAttachmentRepo) Delete(_ context.Context, _, _, attachmentID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.files[attachmentID]; !ok {
+		return &domain.ErrNotFound{Resource: "attachment", ID: attachmentID}
+	}
+	delete(m.files, attachmentID)
+	return nil
+}
+
+func TestAttachmentUseCase_Upload(t *testing.T) {
+	tests := []struct {
+		name     string
+		cardID   string
+		filename string
+		content  string
+		wantErr  bool
+	}{
+		{name: "success", cardID: "card-1", filename: "log.txt", content: "hello"},
+		{name: "too large", cardID: "card-1", filename: "big.bin", content: strings.Repeat("x", 11), wantErr: true},
+		{name: "missing filename", cardID: "card-1", filename: "", content: "hello", wantErr: true},
+		{name: "card not found", cardID: "missing", filename: "log.txt", content: "hello", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentRepo := newMockAttachmentRepo()
+			cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1", Title: "Test", List: "todo"}}
+			uc := usecase.NewAttachmentUseCase(attachmentRepo, usecase.NewCardUseCase(cardRepo, &mockBoardRepo{}), 10)
+
+			got, err := uc.Upload(context.Background(), "board-1", tt.cardID, tt.filename, "text/plain", strings.NewReader(tt.content))
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				if len(attachmentRepo.files) != 0 {
+					t.Errorf("expected no stored files, got %d", len(attachmentRepo.files))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Size != int64(len(tt.content)) {
+				t.Errorf("Size = %d, want %d", got.Size, len(tt.content))
+			}
+			// sha256("hello")
+			if got.SHA256 != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+				t.Errorf("SHA256 = %s", got.SHA256)
+			}
+			if len(cardRepo.savedCard.Attachments) != 1 {
+				t.Errorf("card has %d attachments, want 1", len(cardRepo.savedCard.Attachments))
+			}
+		})
+	}
+}
+
+func TestAttachmentUseCase_Upload_StripsDirectories(t *testing.T) {
+	cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1", Title: "Test", List: "todo"}}
+	uc := usecase.NewAttachmentUseCase(newMockAttachmentRepo(), usecase.NewCardUseCase(cardRepo, &mockBoardRepo{}), 10)
+
+	got, err := uc.Upload(context.Background(), "board-1", "card-1", "../../etc/passwd", "", strings.NewReader("x"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Filename != "passwd" {
+		t.Errorf("Filename = %s, want passwd", got.Filename)
+	}
+	if got.ContentType != "application/octet-stream" {
+		t.Errorf("ContentType = %s, want application/octet-stream", got.ContentType)
+	}
+}
+
+func TestAttachmentUseCase_Upload_ConcurrentWithUpdate(t *testing.T) {
+	_, stored := newMemoryStore(t, nil, map[string][]domain.Card{"board-1": {{ID: "card-1", Title: "Test", List: "todo"}}})
+	cardRepo := &slowCardRepo{stored}
+	cardUC := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+	uc := usecase.NewAttachmentUseCase(newMockAttachmentRepo(), cardUC, 10)
+	ctx := context.Background()
+
+	// Without the board lock, uploads reading the card at the same time would
+	// each save it with only their own attachment added.
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Go(func() {
+			if _, err := uc.Upload(ctx, "board-1", "card-1", "log.txt", "", strings.NewReader("hello")); err != nil {
+				t.Errorf("Upload: %v", err)
+			}
+		})
+	}
+	wg.Go(func() {
+		if _, err := cardUC.Update(ctx, "board-1", "card-1", &domain.Card{Title: "Renamed"}); err != nil {
+			t.Errorf("Update: %v", err)
+		}
+	})
+	wg.Wait()
+
+	card, _ := cardRepo.Get(ctx, "board-1", "card-1")
+	if card.Title != "Renamed" || len(card.Attachments) != 3 {
+		t.Errorf("card = %q with %d attachments, want Renamed with 3", card.Title, len(card.Attachments))
+	}
+}
+
+func TestAttachmentUseCase_OpenAndDelete(t *testing.T) {
+	attachmentRepo := newMockAttachmentRepo()
+	cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1", Title: "Test", List: "todo"}}
+	uc := usecase.NewAttachmentUseCase(attachmentRepo, usecase.NewCardUseCase(cardRepo, &mockBoardRepo{}), 10)
+	ctx := context.Background()
+
+	a, err := uc.Upload(ctx, "board-1", "card-1", "log.txt", "text/plain", strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+
+	meta, rc, err := uc.Open(ctx, "board-1", "card-1", a.ID)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	data, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(data) != "hello" || meta.Filename != "log.txt" {
+		t.Errorf("got %q (%s), want hello (log.txt)", data, meta.Filename)
+	}
+
+	if err := uc.Delete(ctx, "board-1", "card-1", a.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if len(attachmentRepo.files) != 0 {
+		t.Errorf("expected file to be removed")
+	}
+
+	_, _, err = uc.Open(ctx, "board-1", "card-1", a.ID)
+	var nf *domain.ErrNotFound
+	if !errors.As(err, &nf) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
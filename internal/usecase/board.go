@@ -14,8 +14,23 @@ func NewBoardUseCase(repo domain.BoardRepository) *BoardUseCase {
 	return &BoardUseCase{repo: repo}
 }
 
-func (uc *BoardUseCase) List(ctx context.Context) ([]domain.Board, error) {
-	return uc.repo.List(ctx)
+// List returns the boards, leaving out archived ones unless includeArchived.
+func (uc *BoardUseCase) List(ctx context.Context, includeArchived bool) ([]domain.Board, error) {
+	boards, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if includeArchived {
+		return boards, nil
+	}
+
+	active := make([]domain.Board, 0, len(boards))
+	for _, b := range boards {
+		if !b.Archived {
+			active = append(active, b)
+		}
+	}
+	return active, nil
 }
 
 func (uc *BoardUseCase) Get(ctx context.Context, id string) (*domain.Board, error) {
@@ -50,6 +65,18 @@ func (uc *BoardUseCase) Update(ctx context.Context, id string, board *domain.Boa
 	if len(board.Lists) > 0 {
 		existing.Lists = board.Lists
 	}
+	if board.Fields != nil {
+		existing.Fields = board.Fields
+	}
+	if board.CardFormat != "" {
+		existing.CardFormat = board.CardFormat
+	}
+	if board.IDFormat != "" {
+		existing.IDFormat = board.IDFormat
+	}
+	if board.IDPrefix != "" {
+		existing.IDPrefix = board.IDPrefix
+	}
 
 	if err := existing.Validate(); err != nil {
 		return nil, err
@@ -61,6 +88,21 @@ func (uc *BoardUseCase) Update(ctx context.Context, id string, board *domain.Boa
 	return existing, nil
 }
 
+// Archive hides or shows a board in the board list. Its cards stay as they are.
+func (uc *BoardUseCase) Archive(ctx context.Context, id string, archived bool) (*domain.Board, error) {
+	board, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	board.Archived = archived
+	if err := uc.repo.Save(ctx, board); err != nil {
+		return nil, err
+	}
+	return board, nil
+}
+
+// Delete moves the board to the trash, from where TrashUseCase can restore it.
 func (uc *BoardUseCase) Delete(ctx context.Context, id string) error {
 	if _, err := uc.repo.Get(ctx, id); err != nil {
 		return err
@@ -41,17 +41,42 @@ func (m *mockBoardRepo) Delete(_ context.Context, _ string) error {
 }
 
 func TestBoardUseCase_List(t *testing.T) {
-	boards := []domain.Board{{ID: "a", Name: "A"}, {ID: "b", Name: "B"}}
+	boards := []domain.Board{{ID: "a", Name: "A"}, {ID: "b", Name: "B"}, {ID: "c", Name: "C", Archived: true}}
 	repo := &mockBoardRepo{boards: boards}
 	uc := usecase.NewBoardUseCase(repo)
 
-	got, err := uc.List(context.Background())
+	got, err := uc.List(context.Background(), false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if len(got) != 2 {
 		t.Errorf("got %d boards, want 2", len(got))
 	}
+
+	got, err = uc.List(context.Background(), true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 3 {
+		t.Errorf("with archived: got %d boards, want 3", len(got))
+	}
+}
+
+func TestBoardUseCase_Archive(t *testing.T) {
+	repo := &mockBoardRepo{board: &domain.Board{ID: "a", Name: "A", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}}
+	uc := usecase.NewBoardUseCase(repo)
+
+	board, err := uc.Archive(context.Background(), "a", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !board.Archived || !repo.board.Archived {
+		t.Error("board was not archived")
+	}
+
+	if _, err := uc.Archive(context.Background(), "missing", true); err == nil {
+		t.Error("expected error for missing board")
+	}
 }
 
 func TestBoardUseCase_Get(t *testing.T) {
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// BoardTemplateUseCase serves the built-in board templates together with the
+// user-defined ones. A user-defined template replaces a built-in one with the
+// same ID.
+type BoardTemplateUseCase struct {
+	templateRepo domain.BoardTemplateRepository
+	boardUC      *BoardUseCase
+}
+
+func NewBoardTemplateUseCase(templateRepo domain.BoardTemplateRepository, boardUC *BoardUseCase) *BoardTemplateUseCase {
+	return &BoardTemplateUseCase{templateRepo: templateRepo, boardUC: boardUC}
+}
+
+func (uc *BoardTemplateUseCase) List(ctx context.Context) ([]domain.BoardTemplate, error) {
+	custom, err := uc.templateRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]domain.BoardTemplate)
+	for _, t := range domain.BuiltinBoardTemplates() {
+		byID[t.ID] = t
+	}
+	for _, t := range custom {
+		byID[t.ID] = t
+	}
+
+	templates := make([]domain.BoardTemplate, 0, len(byID))
+	for _, t := range byID {
+		templates = append(templates, t)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].ID < templates[j].ID
+	})
+	return templates, nil
+}
+
+func (uc *BoardTemplateUseCase) Get(ctx context.Context, templateID string) (*domain.BoardTemplate, error) {
+	if err := domain.ValidateTemplateID(templateID); err != nil {
+		return nil, err
+	}
+	t, err := uc.templateRepo.Get(ctx, templateID)
+	if err == nil {
+		return t, nil
+	}
+	var notFound *domain.ErrNotFound
+	if !errors.As(err, &notFound) {
+		return nil, err
+	}
+
+	for _, t := range domain.BuiltinBoardTemplates() {
+		if t.ID == templateID {
+			return &t, nil
+		}
+	}
+	return nil, err
+}
+
+// CreateBoard fills board from the template and creates it. An unknown
+// template is reported as a validation error rather than a missing resource.
+func (uc *BoardTemplateUseCase) CreateBoard(ctx context.Context, templateID string, board *domain.Board) (*domain.Board, error) {
+	t, err := uc.Get(ctx, templateID)
+	if err != nil {
+		var notFound *domain.ErrNotFound
+		if errors.As(err, &notFound) {
+			return nil, &domain.ErrValidation{Field: "template", Message: "board template '" + templateID + "' does not exist"}
+		}
+		return nil, err
+	}
+	t.ApplyTo(board)
+	return uc.boardUC.Create(ctx, board)
+}
@@ -0,0 +1,84 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type mockBoardTemplateRepo struct {
+	templates []domain.BoardTemplate
+}
+
+func (m *mockBoardTemplateRepo) List(_ context.Context) ([]domain.BoardTemplate, error) {
+	return m.templates, nil
+}
+
+func (m *mockBoardTemplateRepo) Get(_ context.Context, templateID string) (*domain.BoardTemplate, error) {
+	for i := range m.templates {
+		if m.templates[i].ID == templateID {
+			return &m.templates[i], nil
+		}
+	}
+	return nil, &domain.ErrNotFound{Resource: "board template", ID: templateID}
+}
+
+func TestBoardTemplateUseCase_List(t *testing.T) {
+	repo := &mockBoardTemplateRepo{templates: []domain.BoardTemplate{
+		{ID: "kanban", Name: "Our kanban", Lists: []domain.List{{ID: "todo", Name: "Todo"}}},
+		{ID: "release", Name: "Release", Lists: []domain.List{{ID: "todo", Name: "Todo"}}},
+	}}
+	uc := usecase.NewBoardTemplateUseCase(repo, usecase.NewBoardUseCase(&mockBoardRepo{}))
+
+	templates, err := uc.List(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	names := map[string]string{}
+	for _, tmpl := range templates {
+		names[tmpl.ID] = tmpl.Name
+	}
+	if names["kanban"] != "Our kanban" {
+		t.Errorf("kanban = %q, want the user-defined template", names["kanban"])
+	}
+	if names["scrum"] == "" || names["bug-triage"] == "" || names["release"] == "" {
+		t.Errorf("templates = %v, want built-in and user-defined", names)
+	}
+}
+
+func TestBoardTemplateUseCase_CreateBoard(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		board    domain.Board
+		wantList string
+		wantErr  bool
+	}{
+		{name: "builtin", template: "scrum", board: domain.Board{ID: "b", Name: "B"}, wantList: "backlog"},
+		{name: "own lists win", template: "scrum", board: domain.Board{ID: "b", Name: "B", Lists: []domain.List{{ID: "x", Name: "X"}}}, wantList: "x"},
+		{name: "unknown template", template: "nope", board: domain.Board{ID: "b", Name: "B"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := usecase.NewBoardTemplateUseCase(&mockBoardTemplateRepo{}, usecase.NewBoardUseCase(&mockBoardRepo{}))
+			got, err := uc.CreateBoard(context.Background(), tt.template, &tt.board)
+			if tt.wantErr {
+				var ve *domain.ErrValidation
+				if !errors.As(err, &ve) {
+					t.Errorf("expected ErrValidation, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Lists[0].ID != tt.wantList {
+				t.Errorf("first list = %s, want %s", got.Lists[0].ID, tt.wantList)
+			}
+		})
+	}
+}
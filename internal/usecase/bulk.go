@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// BulkAction is what a BulkOperation does to each of its cards.
+type BulkAction string
+
+const (
+	BulkMove        BulkAction = "move"
+	BulkArchive     BulkAction = "archive"
+	BulkAddLabel    BulkAction = "add_label"
+	BulkRemoveLabel BulkAction = "remove_label"
+	BulkDelete      BulkAction = "delete"
+)
+
+// BulkOperation applies one action to several cards of a board. List is the
+// destination of a move and Label the label to add or remove.
+type BulkOperation struct {
+	Action  BulkAction `json:"action"`
+	CardIDs []string   `json:"card_ids"`
+	List    string     `json:"list,omitempty"`
+	Label   string     `json:"label,omitempty"`
+}
+
+// BulkResult reports what one operation did to one card. Card is the card as
+// it is after the whole request, and nil once deleted. Error says why the
+// card could not be changed.
+type BulkResult struct {
+	CardID string       `json:"card_id"`
+	Action BulkAction   `json:"action"`
+	Card   *domain.Card `json:"card,omitempty"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// Bulk applies operations in order to cards of a board. Every card is
+// checked before anything is written: if any of them cannot be changed, the
+// results say why, a validation error is returned and nothing is applied.
+// Otherwise the changed cards are saved and the deleted ones moved to the
+// trash together, all or nothing. Moved cards go to the end of their new list.
+func (uc *CardUseCase) Bulk(ctx context.Context, boardID string, ops []BulkOperation) ([]BulkResult, error) {
+	defer uc.locks.lock(boardID)()
+
+	board, err := uc.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateBulk(board, ops); err != nil {
+		return nil, err
+	}
+
+	cards, err := uc.cardRepo.ListByBoard(ctx, boardID, true)
+	if err != nil {
+		return nil, err
+	}
+	s := &bulkState{
+		cards:   cards,
+		byID:    make(map[string]*domain.Card, len(cards)),
+		changed: make(map[string]bool),
+		deleted: make(map[string]bool),
+		now:     time.Now(),
+	}
+	for i := range cards {
+		s.byID[cards[i].ID] = &cards[i]
+	}
+
+	var results []BulkResult
+	var deletions []string
+	failed := 0
+	for _, op := range ops {
+		for _, id := range op.CardIDs {
+			res := BulkResult{CardID: id, Action: op.Action}
+			card, ok := s.byID[id]
+			switch {
+			case s.deleted[id]:
+				res.Error = "card is deleted by an earlier operation"
+			case !ok:
+				res.Error = (&domain.ErrNotFound{Resource: "card", ID: id}).Error()
+			default:
+				if err := uc.applyBulk(ctx, board, s, card, op); err != nil {
+					res.Error = err.Error()
+				} else if op.Action == BulkDelete {
+					deletions = append(deletions, id)
+				}
+			}
+			if res.Error != "" {
+				failed++
+			}
+			results = append(results, res)
+		}
+	}
+	for i := range results {
+		if !s.deleted[results[i].CardID] {
+			results[i].Card = s.byID[results[i].CardID]
+		}
+	}
+	if failed > 0 {
+		return results, &domain.ErrValidation{
+			Field:   "operations",
+			Message: fmt.Sprintf("%d of %d cards cannot be changed; nothing was applied", failed, len(results)),
+		}
+	}
+
+	var writes []domain.CardWrite
+	for i := range s.cards {
+		if id := s.cards[i].ID; s.changed[id] && !s.deleted[id] {
+			writes = append(writes, domain.CardWrite{BoardID: boardID, Card: &s.cards[i]})
+		}
+	}
+	for _, id := range deletions {
+		writes = append(writes, domain.CardWrite{BoardID: boardID, Card: s.byID[id], Delete: true})
+	}
+	if err := uc.cardRepo.SaveAll(ctx, writes); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// validateBulk rejects operations that are malformed regardless of the cards
+// they name.
+func validateBulk(board *domain.Board, ops []BulkOperation) error {
+	if len(ops) == 0 {
+		return &domain.ErrValidation{Field: "operations", Message: "must not be empty"}
+	}
+	for i, op := range ops {
+		field := fmt.Sprintf("operations[%d]", i)
+		if len(op.CardIDs) == 0 {
+			return &domain.ErrValidation{Field: field + ".card_ids", Message: "must not be empty"}
+		}
+		switch op.Action {
+		case BulkMove:
+			if !board.HasList(op.List) {
+				return &domain.ErrValidation{
+					Field:   field + ".list",
+					Message: "list '" + op.List + "' does not exist in board",
+				}
+			}
+		case BulkAddLabel, BulkRemoveLabel:
+			if op.Label == "" {
+				return &domain.ErrValidation{Field: field + ".label", Message: "is required"}
+			}
+		case BulkArchive, BulkDelete:
+		default:
+			return &domain.ErrValidation{Field: field + ".action", Message: "unknown action '" + string(op.Action) + "'"}
+		}
+	}
+	return nil
+}
+
+// bulkState is the board as a bulk request has changed it so far.
+type bulkState struct {
+	cards   []domain.Card
+	byID    map[string]*domain.Card
+	changed map[string]bool
+	deleted map[string]bool
+	now     time.Time
+}
+
+// touch marks card as changed and returns it.
+func (s *bulkState) touch(card *domain.Card) *domain.Card {
+	card.UpdatedAt = s.now
+	s.changed[card.ID] = true
+	return card
+}
+
+func (uc *CardUseCase) applyBulk(ctx context.Context, board *domain.Board, s *bulkState, card *domain.Card, op BulkOperation) error {
+	switch op.Action {
+	case BulkMove:
+		if card.List == op.List {
+			return nil
+		}
+		if board.IsDoneList(op.List) {
+			if err := uc.checkUnblocked(ctx, board, card); err != nil {
+				return err
+			}
+		}
+		s.move(card, op.List)
+	case BulkArchive:
+		s.touch(card).Archived = true
+		s.archiveDescendants(card.ID)
+	case BulkAddLabel:
+		if !slices.Contains(card.Labels, op.Label) {
+			s.touch(card).Labels = append(slices.Clip(card.Labels), op.Label)
+		}
+	case BulkRemoveLabel:
+		if slices.Contains(card.Labels, op.Label) {
+			var kept []string
+			for _, l := range card.Labels {
+				if l != op.Label {
+					kept = append(kept, l)
+				}
+			}
+			s.touch(card).Labels = kept
+		}
+	case BulkDelete:
+		s.deleted[card.ID] = true
+		for i := range s.cards {
+			if c := &s.cards[i]; c.Parent == card.ID && !s.deleted[c.ID] {
+				s.touch(c).Parent = ""
+			}
+		}
+	}
+	return nil
+}
+
+// move puts card at the end of the active cards of list.
+func (s *bulkState) move(card *domain.Card, list string) {
+	var others []domain.Card
+	for _, c := range s.cards {
+		if c.List == list && !c.Archived && !s.deleted[c.ID] && c.ID != card.ID {
+			others = append(others, c)
+		}
+	}
+	domain.SortByRank(others)
+
+	card.List = list
+	for _, i := range rankAmong(others, card, len(others)) {
+		s.touch(s.byID[others[i].ID]).Rank = others[i].Rank
+	}
+	s.touch(card)
+}
+
+// archiveDescendants archives every active subtask below cardID, as archiving
+// a single card does.
+func (s *bulkState) archiveDescendants(cardID string) {
+	queue := []string{cardID}
+	visited := map[string]bool{cardID: true}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for i := range s.cards {
+			child := &s.cards[i]
+			if child.Parent != id || visited[child.ID] || child.Archived || s.deleted[child.ID] {
+				continue
+			}
+			visited[child.ID] = true
+			s.touch(child).Archived = true
+			queue = append(queue, child.ID)
+		}
+	}
+}
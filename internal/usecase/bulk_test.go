@@ -0,0 +1,146 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+func newBulkFixture(t *testing.T) (domain.CardRepository, *usecase.CardUseCase) {
+	board := &domain.Board{ID: "board-1", Lists: []domain.List{
+		{ID: "todo", Name: "Todo"}, {ID: "done", Name: "Done", Done: true},
+	}}
+	_, cardRepo := newMemoryStore(t, nil, map[string][]domain.Card{"board-1": {
+		{ID: "card-1", Title: "A", List: "todo", Rank: "a", Labels: []string{"bug"}},
+		{ID: "card-2", Title: "B", List: "todo", Rank: "b"},
+		{ID: "card-3", Title: "C", List: "todo", Rank: "c", Parent: "card-2"},
+		{ID: "card-4", Title: "D", List: "done", Rank: "a"},
+	}})
+	return cardRepo, usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: board})
+}
+
+func TestCardUseCase_Bulk(t *testing.T) {
+	cardRepo, uc := newBulkFixture(t)
+	ctx := context.Background()
+
+	results, err := uc.Bulk(ctx, "board-1", []usecase.BulkOperation{
+		{Action: usecase.BulkMove, CardIDs: []string{"card-1", "card-2"}, List: "done"},
+		{Action: usecase.BulkAddLabel, CardIDs: []string{"card-1", "card-2"}, Label: "triaged"},
+		{Action: usecase.BulkRemoveLabel, CardIDs: []string{"card-1"}, Label: "bug"},
+		{Action: usecase.BulkDelete, CardIDs: []string{"card-2"}},
+		{Action: usecase.BulkArchive, CardIDs: []string{"card-4"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 7 {
+		t.Fatalf("results = %d, want one per card and operation", len(results))
+	}
+	for _, r := range results {
+		if r.Error != "" {
+			t.Errorf("%s %s: %s", r.Action, r.CardID, r.Error)
+		}
+		if deleted := r.CardID == "card-2"; (r.Card == nil) != deleted {
+			t.Errorf("%s %s: card = %v", r.Action, r.CardID, r.Card)
+		}
+	}
+
+	card1, _ := cardRepo.Get(ctx, "board-1", "card-1")
+	if card1.List != "done" || card1.Rank <= "a" || !slices.Equal(card1.Labels, []string{"triaged"}) {
+		t.Errorf("card-1 = %+v", card1)
+	}
+	if _, err := cardRepo.Get(ctx, "board-1", "card-2"); err == nil {
+		t.Error("expected card-2 to be deleted")
+	}
+	if card3, _ := cardRepo.Get(ctx, "board-1", "card-3"); card3.Parent != "" {
+		t.Errorf("card-3 parent = %q, want detached", card3.Parent)
+	}
+	if card4, _ := cardRepo.Get(ctx, "board-1", "card-4"); !card4.Archived {
+		t.Error("expected card-4 to be archived")
+	}
+}
+
+func TestCardUseCase_Bulk_ArchiveDescendants(t *testing.T) {
+	cardRepo, uc := newBulkFixture(t)
+	ctx := context.Background()
+
+	if _, err := uc.Bulk(ctx, "board-1", []usecase.BulkOperation{
+		{Action: usecase.BulkArchive, CardIDs: []string{"card-2"}},
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if card3, _ := cardRepo.Get(ctx, "board-1", "card-3"); !card3.Archived {
+		t.Error("expected subtask card-3 to be archived with its parent")
+	}
+}
+
+func TestCardUseCase_Bulk_NothingAppliedOnError(t *testing.T) {
+	cardRepo, uc := newBulkFixture(t)
+	ctx := context.Background()
+	card1, _ := cardRepo.Get(ctx, "board-1", "card-1")
+	card1.BlockedBy = []domain.CardRef{{Board: "board-1", ID: "card-2"}}
+	if err := cardRepo.Save(ctx, "board-1", card1); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	before, _ := cardRepo.ListByBoard(ctx, "board-1", true)
+
+	results, err := uc.Bulk(ctx, "board-1", []usecase.BulkOperation{
+		{Action: usecase.BulkAddLabel, CardIDs: []string{"card-2", "missing"}, Label: "x"},
+		{Action: usecase.BulkMove, CardIDs: []string{"card-1"}, List: "done"},
+		{Action: usecase.BulkDelete, CardIDs: []string{"card-4"}},
+		{Action: usecase.BulkArchive, CardIDs: []string{"card-4"}},
+	})
+	var validation *domain.ErrValidation
+	if !errors.As(err, &validation) {
+		t.Fatalf("expected ErrValidation, got %v", err)
+	}
+
+	var failed []string
+	for _, r := range results {
+		if r.Error != "" {
+			failed = append(failed, string(r.Action)+" "+r.CardID)
+		}
+	}
+	// card-1 is blocked by card-2, which is still open.
+	want := []string{"add_label missing", "move card-1", "archive card-4"}
+	if !slices.Equal(failed, want) {
+		t.Errorf("failed = %v, want %v", failed, want)
+	}
+	after, _ := cardRepo.ListByBoard(ctx, "board-1", true)
+	if !slices.EqualFunc(after, before, func(a, b domain.Card) bool {
+		return a.ID == b.ID && a.List == b.List && a.UpdatedAt.Equal(b.UpdatedAt) && slices.Equal(a.Labels, b.Labels)
+	}) {
+		t.Errorf("board was changed: %v", after)
+	}
+}
+
+func TestCardUseCase_Bulk_InvalidOperation(t *testing.T) {
+	_, uc := newBulkFixture(t)
+
+	tests := []struct {
+		name string
+		ops  []usecase.BulkOperation
+	}{
+		{"no operations", nil},
+		{"no cards", []usecase.BulkOperation{{Action: usecase.BulkArchive}}},
+		{"unknown action", []usecase.BulkOperation{{Action: "explode", CardIDs: []string{"card-1"}}}},
+		{"unknown list", []usecase.BulkOperation{{Action: usecase.BulkMove, CardIDs: []string{"card-1"}, List: "nope"}}},
+		{"no label", []usecase.BulkOperation{{Action: usecase.BulkAddLabel, CardIDs: []string{"card-1"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := uc.Bulk(context.Background(), "board-1", tt.ops)
+			var validation *domain.ErrValidation
+			if !errors.As(err, &validation) {
+				t.Fatalf("expected ErrValidation, got %v", err)
+			}
+			if results != nil {
+				t.Errorf("results = %v, want none", results)
+			}
+		})
+	}
+}
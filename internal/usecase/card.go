@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"math"
 	"time"
 
 	"github.com/hiroto-aibara/secretary-ai/internal/domain"
@@ -10,17 +11,59 @@ import (
 type CardUseCase struct {
 	cardRepo  domain.CardRepository
 	boardRepo domain.BoardRepository
+	// locks keeps concurrent creates and moves on a board from ranking
+	// against the same neighbours, and concurrent edits of a card from
+	// losing one another: every read-modify-write of cards holds the lock
+	// of their board.
+	locks boardLocks
+	// timers finds the cards where StartTimer must stop a user's timers.
+	timers timerIndex
 }
 
 func NewCardUseCase(cardRepo domain.CardRepository, boardRepo domain.BoardRepository) *CardUseCase {
 	return &CardUseCase{cardRepo: cardRepo, boardRepo: boardRepo}
 }
 
-func (uc *CardUseCase) List(ctx context.Context, boardID string, includeArchived bool) ([]domain.Card, error) {
-	if _, err := uc.boardRepo.Get(ctx, boardID); err != nil {
+// CardFilter narrows the cards returned by CardUseCase.List. Zero values
+// leave the corresponding condition unset.
+type CardFilter struct {
+	IncludeArchived bool
+	// Fields matches custom field values, given in their string form, by field ID.
+	Fields map[string]string
+	// Lists keeps cards in any of the given lists.
+	Lists  []string
+	Labels []string
+	// LabelMatch decides whether a card needs any or all of Labels.
+	LabelMatch LabelMatch
+	// Text is matched case-insensitively against title, description, labels and todos.
+	Text          string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+}
+
+func (uc *CardUseCase) List(ctx context.Context, boardID string, filter CardFilter) ([]domain.Card, error) {
+	board, err := uc.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	if err := filter.validate(board); err != nil {
 		return nil, err
 	}
-	return uc.cardRepo.ListByBoard(ctx, boardID, includeArchived)
+
+	cards, err := uc.cardRepo.ListByBoard(ctx, boardID, filter.IncludeArchived)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := []domain.Card{}
+	for _, c := range cards {
+		if filter.matches(&c) {
+			matched = append(matched, c)
+		}
+	}
+	return matched, nil
 }
 
 func (uc *CardUseCase) Get(ctx context.Context, boardID, cardID string) (*domain.Card, error) {
@@ -28,6 +71,12 @@ func (uc *CardUseCase) Get(ctx context.Context, boardID, cardID string) (*domain
 }
 
 func (uc *CardUseCase) Create(ctx context.Context, boardID string, card *domain.Card) (*domain.Card, error) {
+	defer uc.locks.lock(boardID)()
+	return uc.createLocked(ctx, boardID, card)
+}
+
+// createLocked creates a card while the caller holds the board's lock.
+func (uc *CardUseCase) createLocked(ctx context.Context, boardID string, card *domain.Card) (*domain.Card, error) {
 	board, err := uc.boardRepo.Get(ctx, boardID)
 	if err != nil {
 		return nil, err
@@ -44,6 +93,29 @@ func (uc *CardUseCase) Create(ctx context.Context, boardID string, card *domain.
 		}
 	}
 
+	if card.Parent != "" {
+		if _, err := uc.cardRepo.Get(ctx, boardID, card.Parent); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := normalizeTodos(card.Todos); err != nil {
+		return nil, err
+	}
+
+	if err := board.ValidateFieldValues(card.Fields); err != nil {
+		return nil, err
+	}
+
+	// New cards go to the end of their list.
+	rebalanced, err := uc.placeCard(ctx, boardID, card, math.MaxInt, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.cardRepo.SaveAll(ctx, rebalanced); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	card.CreatedAt = now
 	card.UpdatedAt = now
@@ -58,40 +130,62 @@ func (uc *CardUseCase) Create(ctx context.Context, boardID string, card *domain.
 }
 
 func (uc *CardUseCase) Update(ctx context.Context, boardID, cardID string, updates *domain.Card) (*domain.Card, error) {
-	existing, err := uc.cardRepo.Get(ctx, boardID, cardID)
-	if err != nil {
-		return nil, err
-	}
-
-	if updates.Title != "" {
-		existing.Title = updates.Title
-	}
-	if updates.Description != "" {
-		existing.Description = updates.Description
-	}
-	if updates.Labels != nil {
-		existing.Labels = updates.Labels
-	}
-	if updates.Todos != nil {
-		existing.Todos = updates.Todos
-	}
-
-	existing.UpdatedAt = time.Now()
-
-	if err := uc.cardRepo.Save(ctx, boardID, existing); err != nil {
-		return nil, err
-	}
-	return existing, nil
+	return uc.modifyCard(ctx, boardID, cardID, func(existing *domain.Card) error {
+		if updates.Title != "" {
+			existing.Title = updates.Title
+		}
+		if updates.Description != "" {
+			existing.Description = updates.Description
+		}
+		if updates.Labels != nil {
+			existing.Labels = updates.Labels
+		}
+		if updates.Todos != nil {
+			if err := normalizeTodos(updates.Todos); err != nil {
+				return err
+			}
+			existing.Todos = updates.Todos
+		}
+		if updates.EstimateMinutes != 0 {
+			if updates.EstimateMinutes < 0 {
+				return &domain.ErrValidation{Field: "estimate_minutes", Message: "must not be negative"}
+			}
+			existing.EstimateMinutes = updates.EstimateMinutes
+		}
+		if updates.Fields != nil {
+			board, err := uc.boardRepo.Get(ctx, boardID)
+			if err != nil {
+				return err
+			}
+			fields, err := mergeFields(board, existing.Fields, updates.Fields)
+			if err != nil {
+				return err
+			}
+			existing.Fields = fields
+		}
+		return nil
+	})
 }
 
+// Delete moves a card to the trash and detaches its subtasks, with the board
+// locked so that no subtask is saved in between.
 func (uc *CardUseCase) Delete(ctx context.Context, boardID, cardID string) error {
+	defer uc.locks.lock(boardID)()
+
 	if _, err := uc.cardRepo.Get(ctx, boardID, cardID); err != nil {
 		return err
 	}
-	return uc.cardRepo.Delete(ctx, boardID, cardID)
+	if err := uc.cardRepo.Delete(ctx, boardID, cardID); err != nil {
+		return err
+	}
+	return uc.detachChildren(ctx, boardID, cardID)
 }
 
+// Move puts a card at position order of toList. The board stays locked from
+// reading the neighbouring ranks until the card is saved.
 func (uc *CardUseCase) Move(ctx context.Context, boardID, cardID, toList string, order int) (*domain.Card, error) {
+	defer uc.locks.lock(boardID)()
+
 	board, err := uc.boardRepo.Get(ctx, boardID)
 	if err != nil {
 		return nil, err
@@ -109,81 +203,97 @@ func (uc *CardUseCase) Move(ctx context.Context, boardID, cardID, toList string,
 		return nil, err
 	}
 
-	fromList := card.List
+	if card.List != toList && board.IsDoneList(toList) {
+		if err := uc.checkUnblocked(ctx, board, card); err != nil {
+			return nil, err
+		}
+	}
+
 	card.List = toList
-	card.Order = order
+	writes, err := uc.placeCard(ctx, boardID, card, order, cardID)
+	if err != nil {
+		return nil, err
+	}
 	card.UpdatedAt = time.Now()
 
-	if err := uc.cardRepo.Save(ctx, boardID, card); err != nil {
+	writes = append(writes, domain.CardWrite{BoardID: boardID, Card: card})
+	if err := uc.cardRepo.SaveAll(ctx, writes); err != nil {
 		return nil, err
 	}
+	return card, nil
+}
 
-	if err := uc.reorderList(ctx, boardID, toList, cardID, order); err != nil {
+// placeCard gives card the rank that makes it the index-th active card of
+// card.List, and sets its Order to match. The card is not yet on the board
+// unless it is moving within it, which is when skipID names it. Only card
+// changes, unless the ranks around the new position have grown too long; then
+// the whole list is rebalanced and the other cards that must be saved with
+// card are returned.
+func (uc *CardUseCase) placeCard(ctx context.Context, boardID string, card *domain.Card, index int, skipID string) ([]domain.CardWrite, error) {
+	cards, err := uc.cardRepo.ListByBoard(ctx, boardID, false)
+	if err != nil {
 		return nil, err
 	}
 
-	if fromList != toList {
-		if err := uc.reorderList(ctx, boardID, fromList, "", -1); err != nil {
-			return nil, err
+	var others []domain.Card
+	for _, c := range cards {
+		if c.List == card.List && (skipID == "" || c.ID != skipID) {
+			others = append(others, c)
 		}
 	}
+	domain.SortByRank(others)
 
-	return card, nil
+	var writes []domain.CardWrite
+	now := time.Now()
+	for _, i := range rankAmong(others, card, index) {
+		others[i].UpdatedAt = now
+		writes = append(writes, domain.CardWrite{BoardID: boardID, Card: &others[i]})
+	}
+	return writes, nil
 }
 
-func (uc *CardUseCase) reorderList(ctx context.Context, boardID, listID, movedCardID string, targetOrder int) error {
-	allCards, err := uc.cardRepo.ListByBoard(ctx, boardID, false)
-	if err != nil {
-		return err
-	}
+// rankAmong gives card the rank and order of the index-th position among
+// others, which are sorted by rank. When the ranks there have grown too long,
+// the list is rebalanced; the indexes of the others whose rank changed are
+// returned.
+func rankAmong(others []domain.Card, card *domain.Card, index int) []int {
+	index = min(max(index, 0), len(others))
+	card.Order = index
 
-	var listCards []domain.Card
-	for _, c := range allCards {
-		if c.List == listID {
-			listCards = append(listCards, c)
-		}
+	var prev, next string
+	if index > 0 {
+		prev = others[index-1].Rank
 	}
-
-	if movedCardID != "" {
-		var without []domain.Card
-		var moved *domain.Card
-		for i, c := range listCards {
-			if c.ID == movedCardID {
-				moved = &listCards[i]
-			} else {
-				without = append(without, c)
-			}
-		}
-		if moved != nil {
-			idx := targetOrder
-			if idx > len(without) {
-				idx = len(without)
-			}
-			if idx < 0 {
-				idx = 0
-			}
-			result := make([]domain.Card, 0, len(without)+1)
-			result = append(result, without[:idx]...)
-			result = append(result, *moved)
-			result = append(result, without[idx:]...)
-			listCards = result
+	if index < len(others) {
+		next = others[index].Rank
+	}
+	if next == "" || prev < next {
+		if rank := domain.RankBetween(prev, next); len(rank) <= domain.MaxRankLength {
+			card.Rank = rank
+			return nil
 		}
 	}
 
-	now := time.Now()
-	for i, c := range listCards {
-		if c.Order != i {
-			c.Order = i
-			c.UpdatedAt = now
-			if err := uc.cardRepo.Save(ctx, boardID, &c); err != nil {
-				return err
-			}
+	ranks := domain.RankSequence(len(others) + 1)
+	card.Rank = ranks[index]
+	var changed []int
+	for i := range others {
+		rank := ranks[i]
+		if i >= index {
+			rank = ranks[i+1]
 		}
+		if others[i].Rank == rank {
+			continue
+		}
+		others[i].Rank = rank
+		changed = append(changed, i)
 	}
-	return nil
+	return changed
 }
 
 func (uc *CardUseCase) Archive(ctx context.Context, boardID, cardID string, archived bool) (*domain.Card, error) {
+	defer uc.locks.lock(boardID)()
+
 	card, err := uc.cardRepo.Get(ctx, boardID, cardID)
 	if err != nil {
 		return nil, err
@@ -192,7 +302,16 @@ func (uc *CardUseCase) Archive(ctx context.Context, boardID, cardID string, arch
 	card.Archived = archived
 	card.UpdatedAt = time.Now()
 
-	if err := uc.cardRepo.Save(ctx, boardID, card); err != nil {
+	// Archiving a parent archives its subtasks; restoring only restores the parent.
+	writes := []domain.CardWrite{{BoardID: boardID, Card: card}}
+	if archived {
+		descendants, err := uc.archiveDescendants(ctx, boardID, cardID, card.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		writes = append(writes, descendants...)
+	}
+	if err := uc.cardRepo.SaveAll(ctx, writes); err != nil {
 		return nil, err
 	}
 	return card, nil
@@ -3,7 +3,12 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/hiroto-aibara/secretary-ai/internal/domain"
 	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
@@ -33,6 +38,11 @@ func (m *mockCardRepo) Get(_ context.Context, _, cardID string) (*domain.Card, e
 	if m.card != nil && m.card.ID == cardID {
 		return m.card, nil
 	}
+	for i := range m.cards {
+		if m.cards[i].ID == cardID {
+			return &m.cards[i], nil
+		}
+	}
 	return nil, &domain.ErrNotFound{Resource: "card", ID: cardID}
 }
 
@@ -41,6 +51,15 @@ func (m *mockCardRepo) Save(_ context.Context, _ string, card *domain.Card) erro
 	return m.saveErr
 }
 
+func (m *mockCardRepo) SaveAll(ctx context.Context, writes []domain.CardWrite) error {
+	for _, w := range writes {
+		if err := m.Save(ctx, w.BoardID, w.Card); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *mockCardRepo) Delete(_ context.Context, _, _ string) error {
 	return m.delErr
 }
@@ -61,13 +80,18 @@ func (m *mockCardRepo) Create(_ context.Context, _ string, card *domain.Card) (s
 	return m.nextID, nil
 }
 
+func (m *mockCardRepo) Transfer(_ context.Context, _, _ string, card *domain.Card) (string, error) {
+	m.savedCard = card
+	return card.ID, m.saveErr
+}
+
 func TestCardUseCase_List(t *testing.T) {
 	cards := []domain.Card{{ID: "1", Title: "A"}, {ID: "2", Title: "B"}}
 	cardRepo := &mockCardRepo{cards: cards}
 	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1"}}
 	uc := usecase.NewCardUseCase(cardRepo, boardRepo)
 
-	got, err := uc.List(context.Background(), "board-1", false)
+	got, err := uc.List(context.Background(), "board-1", usecase.CardFilter{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -81,7 +105,7 @@ func TestCardUseCase_List_BoardNotFound(t *testing.T) {
 	boardRepo := &mockBoardRepo{}
 	uc := usecase.NewCardUseCase(cardRepo, boardRepo)
 
-	_, err := uc.List(context.Background(), "missing", false)
+	_, err := uc.List(context.Background(), "missing", usecase.CardFilter{})
 	if err == nil {
 		t.Error("expected error, got nil")
 	}
@@ -561,3 +585,149 @@ func TestCardUseCase_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestCardUseCase_Move_Rank(t *testing.T) {
+	board := &domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}
+	_, cardRepo := newMemoryStore(t, nil, map[string][]domain.Card{"board-1": {
+		{ID: "card-1", Title: "A", List: "todo", Rank: "a"},
+		{ID: "card-2", Title: "B", List: "todo", Rank: "b"},
+		{ID: "card-3", Title: "C", List: "todo", Rank: "c"},
+	}})
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: board})
+	ctx := context.Background()
+
+	moved, err := uc.Move(ctx, "board-1", "card-3", "todo", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if moved.Rank <= "a" || moved.Rank >= "b" || moved.Order != 1 {
+		t.Errorf("rank = %q, order = %d; want between a and b at 1", moved.Rank, moved.Order)
+	}
+	// Only the moved card is ranked.
+	for id, want := range map[string]string{"card-1": "a", "card-2": "b"} {
+		if c, _ := cardRepo.Get(ctx, "board-1", id); c.Rank != want {
+			t.Errorf("%s rank = %q, want %q", id, c.Rank, want)
+		}
+	}
+
+	created, err := uc.Create(ctx, "board-1", &domain.Card{Title: "D", List: "todo"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.Rank <= "b" || created.Order != 3 {
+		t.Errorf("created rank = %q, order = %d; want last", created.Rank, created.Order)
+	}
+}
+
+func TestCardUseCase_Move_Rebalance(t *testing.T) {
+	board := &domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}
+	long := strings.Repeat("a", domain.MaxRankLength)
+	_, cardRepo := newMemoryStore(t, nil, map[string][]domain.Card{"board-1": {
+		{ID: "card-1", Title: "A", List: "todo", Rank: long},
+		{ID: "card-2", Title: "B", List: "todo", Rank: long + "1"},
+		{ID: "card-3", Title: "C", List: "todo", Rank: "b"},
+	}})
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: board})
+	ctx := context.Background()
+
+	if _, err := uc.Move(ctx, "board-1", "card-3", "todo", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cards, _ := cardRepo.ListByBoard(ctx, "board-1", false)
+	domain.SortByRank(cards)
+	var ids []string
+	for _, c := range cards {
+		if len(c.Rank) > domain.MaxRankLength {
+			t.Errorf("card %s rank %q was not rebalanced", c.ID, c.Rank)
+		}
+		ids = append(ids, c.ID)
+	}
+	if want := []string{"card-1", "card-3", "card-2"}; !slices.Equal(ids, want) {
+		t.Errorf("order = %v, want %v", ids, want)
+	}
+}
+
+func TestCardUseCase_Move_RebalanceAllOrNothing(t *testing.T) {
+	board := &domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}
+	long := strings.Repeat("a", domain.MaxRankLength)
+	before := []domain.Card{
+		{ID: "card-1", Title: "A", List: "todo", Rank: long},
+		{ID: "card-2", Title: "B", List: "todo", Rank: long + "1"},
+		{ID: "card-3", Title: "C", List: "todo", Rank: "b"},
+	}
+	_, stored := newMemoryStore(t, nil, map[string][]domain.Card{"board-1": slices.Clone(before)})
+	cardRepo := &failingCardRepo{CardRepository: stored, saveAllErr: errors.New("disk full")}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: board})
+	ctx := context.Background()
+
+	if _, err := uc.Move(ctx, "board-1", "card-3", "todo", 1); err == nil {
+		t.Fatal("expected error")
+	}
+	after, _ := stored.ListByBoard(ctx, "board-1", true)
+	if !slices.EqualFunc(after, before, func(a, b domain.Card) bool { return a.ID == b.ID && a.Rank == b.Rank }) {
+		t.Errorf("board was partly rewritten: %v", after)
+	}
+}
+
+// failingCardRepo fails SaveAll before any card is written.
+type failingCardRepo struct {
+	domain.CardRepository
+	saveAllErr error
+}
+
+func (m *failingCardRepo) SaveAll(context.Context, []domain.CardWrite) error {
+	return m.saveAllErr
+}
+
+// slowCardRepo pauses after listing and getting cards, so that a caller
+// ranking against the listed cards, or changing the card it got, overlaps
+// with others.
+type slowCardRepo struct {
+	domain.CardRepository
+}
+
+func (m *slowCardRepo) ListByBoard(ctx context.Context, boardID string, includeArchived bool) ([]domain.Card, error) {
+	cards, err := m.CardRepository.ListByBoard(ctx, boardID, includeArchived)
+	time.Sleep(time.Millisecond)
+	return cards, err
+}
+
+func (m *slowCardRepo) Get(ctx context.Context, boardID, cardID string) (*domain.Card, error) {
+	card, err := m.CardRepository.Get(ctx, boardID, cardID)
+	time.Sleep(time.Millisecond)
+	return card, err
+}
+
+func TestCardUseCase_Move_Concurrent(t *testing.T) {
+	board := &domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}, {ID: "done", Name: "Done"}}}
+	var seeded []domain.Card
+	for i := range 10 {
+		seeded = append(seeded, domain.Card{ID: fmt.Sprintf("card-%d", i), Title: "T", List: "todo", Rank: domain.RankFromOrder(i)})
+	}
+	_, stored := newMemoryStore(t, nil, map[string][]domain.Card{"board-1": seeded})
+	cardRepo := &slowCardRepo{stored}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: board})
+	ctx := context.Background()
+
+	// Every card moves to the top of the done list at once. Without the board
+	// lock, moves ranked against the same neighbours would share a rank.
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() {
+			if _, err := uc.Move(ctx, "board-1", fmt.Sprintf("card-%d", i), "done", 0); err != nil {
+				t.Errorf("Move: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	cards, _ := cardRepo.ListByBoard(ctx, "board-1", false)
+	seen := map[string]bool{}
+	for _, c := range cards {
+		if c.List != "done" || seen[c.Rank] {
+			t.Errorf("card %s: list %s, rank %q duplicated = %v", c.ID, c.List, c.Rank, seen[c.Rank])
+		}
+		seen[c.Rank] = true
+	}
+}
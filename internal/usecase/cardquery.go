@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// LabelMatch selects how CardFilter.Labels is applied.
+type LabelMatch string
+
+const (
+	LabelMatchAny LabelMatch = "any"
+	LabelMatchAll LabelMatch = "all"
+)
+
+// CardSort is a field cards can be ordered by.
+type CardSort string
+
+const (
+	// CardSortPosition orders cards by list and then by their rank within it.
+	CardSortPosition  CardSort = "position"
+	CardSortTitle     CardSort = "title"
+	CardSortCreatedAt CardSort = "created_at"
+	CardSortUpdatedAt CardSort = "updated_at"
+)
+
+// MaxCardPageSize caps CardQuery.Limit.
+const MaxCardPageSize = 200
+
+// CardQuery is a filtered, sorted and paginated card listing. An empty Sort
+// means CardSortPosition, and Limit 0 returns every remaining card.
+type CardQuery struct {
+	Filter CardFilter
+	Sort   CardSort
+	Desc   bool
+	// Cursor is the NextCursor of the previous page, or empty for the first page.
+	Cursor string
+	Limit  int
+}
+
+// CardPage is one page of a CardQuery. NextCursor is empty on the last page.
+type CardPage struct {
+	Cards      []domain.Card
+	NextCursor string
+}
+
+// ListPage returns the cards matching q. Cursors are keyed on the sort value of
+// the last card returned, so cards added or removed between requests do not
+// shift later pages.
+func (uc *CardUseCase) ListPage(ctx context.Context, boardID string, q CardQuery) (*CardPage, error) {
+	if q.Sort == "" {
+		q.Sort = CardSortPosition
+	}
+	if err := validateSort(q.Sort); err != nil {
+		return nil, err
+	}
+	if q.Limit < 0 || q.Limit > MaxCardPageSize {
+		return nil, &domain.ErrValidation{Field: "limit", Message: "must be between 1 and 200"}
+	}
+
+	var after *cardCursor
+	if q.Cursor != "" {
+		c, err := decodeCardCursor(q.Cursor)
+		if err != nil || c.Sort != q.Sort || c.Desc != q.Desc {
+			return nil, &domain.ErrValidation{Field: "cursor", Message: "is invalid for this query"}
+		}
+		after = c
+	}
+
+	cards, err := uc.List(ctx, boardID, q.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	compare := func(a, b *domain.Card) int {
+		c := compareCards(a, b, q.Sort)
+		if q.Desc {
+			return -c
+		}
+		return c
+	}
+	slices.SortStableFunc(cards, func(a, b domain.Card) int { return compare(&a, &b) })
+
+	if after != nil {
+		key := after.card()
+		start, _ := slices.BinarySearchFunc(cards, key, func(c domain.Card, k *domain.Card) int {
+			if compare(&c, k) <= 0 {
+				return -1
+			}
+			return 1
+		})
+		cards = cards[start:]
+	}
+
+	page := &CardPage{Cards: cards}
+	if q.Limit > 0 && len(cards) > q.Limit {
+		page.Cards = cards[:q.Limit]
+		page.NextCursor = encodeCardCursor(&page.Cards[q.Limit-1], q.Sort, q.Desc)
+	}
+	return page, nil
+}
+
+func validateSort(sort CardSort) error {
+	switch sort {
+	case CardSortPosition, CardSortTitle, CardSortCreatedAt, CardSortUpdatedAt:
+		return nil
+	}
+	return &domain.ErrValidation{Field: "sort", Message: "must be one of position, title, created_at, updated_at"}
+}
+
+func compareCards(a, b *domain.Card, sort CardSort) int {
+	var c int
+	switch sort {
+	case CardSortPosition:
+		c = cmp.Or(cmp.Compare(a.List, b.List), cmp.Compare(a.Rank, b.Rank))
+	case CardSortTitle:
+		c = cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+	case CardSortCreatedAt:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	case CardSortUpdatedAt:
+		c = a.UpdatedAt.Compare(b.UpdatedAt)
+	}
+	return cmp.Or(c, cmp.Compare(a.ID, b.ID))
+}
+
+func (f *CardFilter) validate(board *domain.Board) error {
+	for id := range f.Fields {
+		if _, ok := board.Field(id); !ok {
+			return &domain.ErrValidation{Field: "fields." + id, Message: "is not defined on this board"}
+		}
+	}
+	for _, l := range f.Lists {
+		if !board.HasList(l) {
+			return &domain.ErrValidation{Field: "list", Message: "list '" + l + "' does not exist in board"}
+		}
+	}
+	switch f.LabelMatch {
+	case "", LabelMatchAny, LabelMatchAll:
+	default:
+		return &domain.ErrValidation{Field: "label_match", Message: "must be any or all"}
+	}
+	return nil
+}
+
+func (f *CardFilter) matches(c *domain.Card) bool {
+	if len(f.Lists) > 0 && !slices.Contains(f.Lists, c.List) {
+		return false
+	}
+	if len(f.Labels) > 0 && !matchLabels(c.Labels, f.Labels, f.LabelMatch == LabelMatchAll) {
+		return false
+	}
+	if f.Text != "" && !matchText(c, f.Text) {
+		return false
+	}
+	if !inRange(c.CreatedAt, f.CreatedAfter, f.CreatedBefore) || !inRange(c.UpdatedAt, f.UpdatedAfter, f.UpdatedBefore) {
+		return false
+	}
+	return len(f.Fields) == 0 || matchFields(c.Fields, f.Fields)
+}
+
+func matchLabels(have, want []string, all bool) bool {
+	for _, w := range want {
+		found := slices.Contains(have, w)
+		if found && !all {
+			return true
+		}
+		if !found && all {
+			return false
+		}
+	}
+	return all
+}
+
+func matchText(c *domain.Card, text string) bool {
+	text = strings.ToLower(text)
+	contains := func(s string) bool { return strings.Contains(strings.ToLower(s), text) }
+
+	if contains(c.Title) || contains(c.Description) || slices.ContainsFunc(c.Labels, contains) {
+		return true
+	}
+	for _, t := range c.Todos {
+		if contains(t.Text) {
+			return true
+		}
+	}
+	return false
+}
+
+// inRange reports whether t is within [after, before); a zero bound is open.
+func inRange(t, after, before time.Time) bool {
+	if !after.IsZero() && t.Before(after) {
+		return false
+	}
+	return before.IsZero() || t.Before(before)
+}
+
+// cardCursor holds the sort key of the last card on a page.
+type cardCursor struct {
+	Sort      CardSort  `json:"s"`
+	Desc      bool      `json:"d,omitempty"`
+	ID        string    `json:"id"`
+	List      string    `json:"l,omitempty"`
+	Rank      string    `json:"r,omitempty"`
+	Title     string    `json:"t,omitempty"`
+	CreatedAt time.Time `json:"c,omitzero"`
+	UpdatedAt time.Time `json:"u,omitzero"`
+}
+
+func (c *cardCursor) card() *domain.Card {
+	return &domain.Card{ID: c.ID, List: c.List, Rank: c.Rank, Title: c.Title, CreatedAt: c.CreatedAt, UpdatedAt: c.UpdatedAt}
+}
+
+func encodeCardCursor(last *domain.Card, sort CardSort, desc bool) string {
+	c := cardCursor{Sort: sort, Desc: desc, ID: last.ID}
+	switch sort {
+	case CardSortPosition:
+		c.List, c.Rank = last.List, last.Rank
+	case CardSortTitle:
+		c.Title = last.Title
+	case CardSortCreatedAt:
+		c.CreatedAt = last.CreatedAt
+	case CardSortUpdatedAt:
+		c.UpdatedAt = last.UpdatedAt
+	}
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCardCursor(s string) (*cardCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var c cardCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,133 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+func queryFixture() *usecase.CardUseCase {
+	board := domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}, {ID: "done", Name: "Done"}}}
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 9, 0, 0, 0, time.UTC) }
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "Login form", List: "todo", Rank: "b", Labels: []string{"auth", "ui"}, CreatedAt: day(1), UpdatedAt: day(5)},
+		{ID: "card-2", Title: "api docs", List: "todo", Rank: "a", Labels: []string{"docs"}, CreatedAt: day(2), UpdatedAt: day(2)},
+		{ID: "card-3", Title: "Session store", List: "done", Rank: "a", Labels: []string{"auth"}, Description: "Keep LOGIN state", CreatedAt: day(3), UpdatedAt: day(3)},
+		{ID: "card-4", Title: "Billing", List: "done", Rank: "b", Todos: []domain.TodoItem{{Text: "login to stripe"}}, CreatedAt: day(4), UpdatedAt: day(4)},
+	}}
+	return usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: &board})
+}
+
+func cardIDs(cards []domain.Card) []string {
+	ids := make([]string, len(cards))
+	for i, c := range cards {
+		ids[i] = c.ID
+	}
+	return ids
+}
+
+func TestCardUseCase_ListPage(t *testing.T) {
+	tests := []struct {
+		name  string
+		query usecase.CardQuery
+		want  []string
+	}{
+		{name: "default position order", want: []string{"card-3", "card-4", "card-2", "card-1"}},
+		{name: "list", query: usecase.CardQuery{Filter: usecase.CardFilter{Lists: []string{"todo"}}}, want: []string{"card-2", "card-1"}},
+		{name: "labels any", query: usecase.CardQuery{Filter: usecase.CardFilter{Labels: []string{"ui", "docs"}}}, want: []string{"card-2", "card-1"}},
+		{name: "labels all", query: usecase.CardQuery{Filter: usecase.CardFilter{Labels: []string{"auth", "ui"}, LabelMatch: usecase.LabelMatchAll}}, want: []string{"card-1"}},
+		{name: "text", query: usecase.CardQuery{Filter: usecase.CardFilter{Text: "login"}, Sort: usecase.CardSortCreatedAt}, want: []string{"card-1", "card-3", "card-4"}},
+		{name: "created range", query: usecase.CardQuery{Filter: usecase.CardFilter{
+			CreatedAfter:  time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+			CreatedBefore: time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC),
+		}, Sort: usecase.CardSortCreatedAt}, want: []string{"card-2", "card-3"}},
+		{name: "updated after", query: usecase.CardQuery{Filter: usecase.CardFilter{UpdatedAfter: time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)}, Sort: usecase.CardSortUpdatedAt}, want: []string{"card-4", "card-1"}},
+		{name: "title ignores case", query: usecase.CardQuery{Sort: usecase.CardSortTitle}, want: []string{"card-2", "card-4", "card-1", "card-3"}},
+		{name: "descending", query: usecase.CardQuery{Sort: usecase.CardSortCreatedAt, Desc: true}, want: []string{"card-4", "card-3", "card-2", "card-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := queryFixture().ListPage(context.Background(), "board-1", tt.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := cardIDs(page.Cards)
+			if len(got) != len(tt.want) {
+				t.Fatalf("cards = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("cards = %v, want %v", got, tt.want)
+					break
+				}
+			}
+			if page.NextCursor != "" {
+				t.Errorf("NextCursor = %q, want empty", page.NextCursor)
+			}
+		})
+	}
+}
+
+func TestCardUseCase_ListPage_Cursor(t *testing.T) {
+	uc := queryFixture()
+	q := usecase.CardQuery{Sort: usecase.CardSortUpdatedAt, Desc: true, Limit: 3}
+
+	var got []string
+	for range 3 {
+		page, err := uc.ListPage(context.Background(), "board-1", q)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, cardIDs(page.Cards)...)
+		if page.NextCursor == "" {
+			break
+		}
+		q.Cursor = page.NextCursor
+	}
+
+	want := []string{"card-1", "card-4", "card-3", "card-2"}
+	if len(got) != len(want) {
+		t.Fatalf("cards = %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("cards = %v, want %v", got, want)
+		}
+	}
+
+	q.Desc = false
+	_, err := uc.ListPage(context.Background(), "board-1", q)
+	var ve *domain.ErrValidation
+	if !errors.As(err, &ve) || ve.Field != "cursor" {
+		t.Errorf("expected cursor ErrValidation for a changed sort, got %v", err)
+	}
+}
+
+func TestCardUseCase_ListPage_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		query usecase.CardQuery
+		field string
+	}{
+		{name: "unknown list", query: usecase.CardQuery{Filter: usecase.CardFilter{Lists: []string{"todo", "nope"}}}, field: "list"},
+		{name: "unknown label match", query: usecase.CardQuery{Filter: usecase.CardFilter{Labels: []string{"a"}, LabelMatch: "some"}}, field: "label_match"},
+		{name: "unknown sort", query: usecase.CardQuery{Sort: "priority"}, field: "sort"},
+		{name: "limit too large", query: usecase.CardQuery{Limit: usecase.MaxCardPageSize + 1}, field: "limit"},
+		{name: "garbage cursor", query: usecase.CardQuery{Cursor: "!!"}, field: "cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := queryFixture().ListPage(context.Background(), "board-1", tt.query)
+			var ve *domain.ErrValidation
+			if !errors.As(err, &ve) || ve.Field != tt.field {
+				t.Errorf("expected ErrValidation on %s, got %v", tt.field, err)
+			}
+		})
+	}
+}
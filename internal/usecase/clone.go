@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// CloneOptions selects what BoardCloneUseCase.Clone copies besides the lists,
+// custom fields and saved views, which are always copied.
+type CloneOptions struct {
+	// Cards copies the active cards under new IDs.
+	Cards bool
+	// Labels keeps the labels of copied cards.
+	Labels bool
+	// Templates copies the card templates.
+	Templates bool
+}
+
+type BoardCloneUseCase struct {
+	boardRepo    domain.BoardRepository
+	cardRepo     domain.CardRepository
+	templateRepo domain.TemplateRepository
+	viewRepo     domain.ViewRepository
+}
+
+func NewBoardCloneUseCase(boardRepo domain.BoardRepository, cardRepo domain.CardRepository, templateRepo domain.TemplateRepository, viewRepo domain.ViewRepository) *BoardCloneUseCase {
+	return &BoardCloneUseCase{boardRepo: boardRepo, cardRepo: cardRepo, templateRepo: templateRepo, viewRepo: viewRepo}
+}
+
+// Clone copies boardID to a new board with the given ID and name; an empty
+// name keeps the source name. Copied cards start fresh: comments, attachments,
+// time entries and archived cards are left behind, and parent and blocked-by
+// links between copied cards point to the copies.
+func (uc *BoardCloneUseCase) Clone(ctx context.Context, boardID, newID, name string, opts CloneOptions) (*domain.Board, error) {
+	src, err := uc.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	board := &domain.Board{
+		ID:     newID,
+		Name:   name,
+		Lists:  slices.Clone(src.Lists),
+		Fields: slices.Clone(src.Fields),
+	}
+	if board.Name == "" {
+		board.Name = src.Name
+	}
+	if err := board.Validate(); err != nil {
+		return nil, err
+	}
+	if existing, err := uc.boardRepo.Get(ctx, newID); err == nil && existing != nil {
+		return nil, &domain.ErrConflict{Resource: "board", ID: newID}
+	}
+
+	if err := uc.boardRepo.Save(ctx, board); err != nil {
+		return nil, err
+	}
+
+	views, err := uc.viewRepo.ListByBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range views {
+		if err := uc.viewRepo.Save(ctx, newID, &views[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Templates {
+		templates, err := uc.templateRepo.ListByBoard(ctx, boardID)
+		if err != nil {
+			return nil, err
+		}
+		for i := range templates {
+			if err := uc.templateRepo.Save(ctx, newID, &templates[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if opts.Cards {
+		if err := uc.cloneCards(ctx, boardID, newID, opts.Labels); err != nil {
+			return nil, err
+		}
+	}
+	return board, nil
+}
+
+func (uc *BoardCloneUseCase) cloneCards(ctx context.Context, boardID, newID string, labels bool) error {
+	cards, err := uc.cardRepo.ListByBoard(ctx, boardID, false)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	ids := make(map[string]string, len(cards))
+	copies := make([]domain.Card, len(cards))
+	for i, c := range cards {
+		cp := c
+		cp.ID = ""
+		cp.Parent = ""
+		cp.BlockedBy = nil
+		cp.Attachments = nil
+		cp.TimeEntries = nil
+		cp.Recurrence = ""
+		cp.CreatedAt = now
+		cp.UpdatedAt = now
+		if !labels {
+			cp.Labels = nil
+		}
+		id, err := uc.cardRepo.Create(ctx, newID, &cp)
+		if err != nil {
+			return err
+		}
+		cp.ID = id
+		ids[c.ID] = id
+		copies[i] = cp
+	}
+
+	// Links can only be set once every copy has its new ID. Links to cards
+	// that were not copied are dropped; links to other boards are kept.
+	for i, c := range cards {
+		if c.Parent == "" && len(c.BlockedBy) == 0 {
+			continue
+		}
+		cp := &copies[i]
+		cp.Parent = ids[c.Parent]
+		for _, ref := range c.BlockedBy {
+			if ref.Board != boardID {
+				cp.BlockedBy = append(cp.BlockedBy, ref)
+			} else if id, ok := ids[ref.ID]; ok {
+				cp.BlockedBy = append(cp.BlockedBy, domain.CardRef{Board: newID, ID: id})
+			}
+		}
+		if err := uc.cardRepo.Save(ctx, newID, cp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type mockTemplateRepo struct {
+	templates map[string][]domain.CardTemplate
+}
+
+func (m *mockTemplateRepo) ListByBoard(_ context.Context, boardID string) ([]domain.CardTemplate, error) {
+	return m.templates[boardID], nil
+}
+
+func (m *mockTemplateRepo) Get(_ context.Context, boardID, templateID string) (*domain.CardTemplate, error) {
+	for _, t := range m.templates[boardID] {
+		if t.ID == templateID {
+			return &t, nil
+		}
+	}
+	return nil, &domain.ErrNotFound{Resource: "template", ID: templateID}
+}
+
+func (m *mockTemplateRepo) Save(_ context.Context, boardID string, t *domain.CardTemplate) error {
+	m.templates[boardID] = append(m.templates[boardID], *t)
+	return nil
+}
+
+type cloneFixture struct {
+	uc        *usecase.BoardCloneUseCase
+	cards     domain.CardRepository
+	templates *mockTemplateRepo
+}
+
+func newCloneFixture(t *testing.T) *cloneFixture {
+	store, cards := newMemoryStore(t, []domain.Board{
+		{ID: "src", Name: "Source", Lists: []domain.List{{ID: "todo", Name: "Todo"}}},
+	}, map[string][]domain.Card{
+		"src": {
+			{ID: "epic", Title: "Epic", List: "todo", Labels: []string{"auth"}},
+			{ID: "task", Title: "Task", List: "todo", Parent: "epic", BlockedBy: []domain.CardRef{
+				{Board: "src", ID: "epic"}, {Board: "src", ID: "old"}, {Board: "other", ID: "x"},
+			}, TimeEntries: []domain.TimeEntry{{ID: "e1", User: "alice"}}},
+			{ID: "old", Title: "Old", List: "todo", Archived: true},
+		},
+	})
+	f := &cloneFixture{
+		cards: cards,
+		templates: &mockTemplateRepo{templates: map[string][]domain.CardTemplate{
+			"src": {{ID: "bug", Name: "Bug"}},
+		}},
+	}
+	f.uc = usecase.NewBoardCloneUseCase(store, f.cards, f.templates, &mockViewRepo{})
+	return f
+}
+
+func TestBoardCloneUseCase_Clone(t *testing.T) {
+	f := newCloneFixture(t)
+	ctx := context.Background()
+
+	board, err := f.uc.Clone(ctx, "src", "dst", "", usecase.CloneOptions{Cards: true, Templates: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if board.Name != "Source" || len(board.Lists) != 1 {
+		t.Errorf("board = %+v", board)
+	}
+	if len(f.templates.templates["dst"]) != 1 {
+		t.Errorf("templates = %v, want bug copied", f.templates.templates["dst"])
+	}
+
+	cards, _ := f.cards.ListByBoard(ctx, "dst", true)
+	if len(cards) != 2 {
+		t.Fatalf("got %d cards, want 2 (archived cards are not copied)", len(cards))
+	}
+	byTitle := map[string]domain.Card{}
+	for _, c := range cards {
+		if c.ID == "epic" || c.ID == "task" {
+			t.Errorf("card %s kept its source ID", c.ID)
+		}
+		if len(c.Labels) != 0 {
+			t.Errorf("card %s kept labels %v", c.ID, c.Labels)
+		}
+		byTitle[c.Title] = c
+	}
+	epic, task := byTitle["Epic"], byTitle["Task"]
+	if task.Parent != epic.ID {
+		t.Errorf("Parent = %s, want %s", task.Parent, epic.ID)
+	}
+	want := []domain.CardRef{{Board: "dst", ID: epic.ID}, {Board: "other", ID: "x"}}
+	if len(task.BlockedBy) != 2 || task.BlockedBy[0] != want[0] || task.BlockedBy[1] != want[1] {
+		t.Errorf("BlockedBy = %v, want %v", task.BlockedBy, want)
+	}
+	if len(task.TimeEntries) != 0 {
+		t.Error("expected time entries not to be copied")
+	}
+}
+
+func TestBoardCloneUseCase_Clone_Labels(t *testing.T) {
+	f := newCloneFixture(t)
+	ctx := context.Background()
+
+	if _, err := f.uc.Clone(ctx, "src", "dst", "Copy", usecase.CloneOptions{Cards: true, Labels: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cards, _ := f.cards.ListByBoard(ctx, "dst", true)
+	for _, c := range cards {
+		if c.Title == "Epic" && (len(c.Labels) != 1 || c.Labels[0] != "auth") {
+			t.Errorf("Labels = %v, want [auth]", c.Labels)
+		}
+	}
+	if len(f.templates.templates["dst"]) != 0 {
+		t.Error("expected templates not to be copied")
+	}
+}
+
+func TestBoardCloneUseCase_Clone_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		newID   string
+		wantErr func(error) bool
+	}{
+		{name: "source not found", src: "missing", newID: "dst", wantErr: func(err error) bool {
+			var e *domain.ErrNotFound
+			return errors.As(err, &e)
+		}},
+		{name: "missing id", src: "src", newID: "", wantErr: func(err error) bool {
+			var e *domain.ErrValidation
+			return errors.As(err, &e)
+		}},
+		{name: "id taken", src: "src", newID: "src", wantErr: func(err error) bool {
+			var e *domain.ErrConflict
+			return errors.As(err, &e)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newCloneFixture(t).uc.Clone(context.Background(), tt.src, tt.newID, "", usecase.CloneOptions{})
+			if !tt.wantErr(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
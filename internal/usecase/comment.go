@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+type CommentUseCase struct {
+	commentRepo domain.CommentRepository
+	cardRepo    domain.CardRepository
+}
+
+func NewCommentUseCase(commentRepo domain.CommentRepository, cardRepo domain.CardRepository) *CommentUseCase {
+	return &CommentUseCase{commentRepo: commentRepo, cardRepo: cardRepo}
+}
+
+func (uc *CommentUseCase) List(ctx context.Context, boardID, cardID string) ([]domain.Comment, error) {
+	if _, err := uc.cardRepo.Get(ctx, boardID, cardID); err != nil {
+		return nil, err
+	}
+	return uc.commentRepo.ListByCard(ctx, boardID, cardID)
+}
+
+func (uc *CommentUseCase) Create(ctx context.Context, boardID, cardID string, comment *domain.Comment) (*domain.Comment, error) {
+	if _, err := uc.cardRepo.Get(ctx, boardID, cardID); err != nil {
+		return nil, err
+	}
+
+	if err := comment.Validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	comment.CreatedAt = now
+	comment.UpdatedAt = now
+
+	id, err := uc.commentRepo.Create(ctx, boardID, cardID, comment)
+	if err != nil {
+		return nil, err
+	}
+	comment.ID = id
+	return comment, nil
+}
+
+// Update replaces the body of a comment. The author is fixed at creation.
+func (uc *CommentUseCase) Update(ctx context.Context, boardID, cardID, commentID, body string) (*domain.Comment, error) {
+	existing, err := uc.commentRepo.Get(ctx, boardID, cardID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing.Body = body
+	if err := existing.Validate(); err != nil {
+		return nil, err
+	}
+	existing.UpdatedAt = time.Now()
+
+	if err := uc.commentRepo.Save(ctx, boardID, cardID, existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (uc *CommentUseCase) Delete(ctx context.Context, boardID, cardID, commentID string) error {
+	if _, err := uc.commentRepo.Get(ctx, boardID, cardID, commentID); err != nil {
+		return err
+	}
+	return uc.commentRepo.Delete(ctx, boardID, cardID, commentID)
+}
@@ -0,0 +1,170 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type mockCommentRepo struct {
+	comments  []domain.Comment
+	createErr error
+	saveErr   error
+	saved     *domain.Comment
+	deletedID string
+}
+
+func (m *mockCommentRepo) ListByCard(_ context.Context, _, _ string) ([]domain.Comment, error) {
+	return m.comments, nil
+}
+
+func (m *mockCommentRepo) Get(_ context.Context, _, _, commentID string) (*domain.Comment, error) {
+	for i := range m.comments {
+		if m.comments[i].ID == commentID {
+			return &m.comments[i], nil
+		}
+	}
+	return nil, &domain.ErrNotFound{Resource: "comment", ID: commentID}
+}
+
+func (m *mockCommentRepo) Create(_ context.Context, _, _ string, comment *domain.Comment) (string, error) {
+	if m.createErr != nil {
+		return "", m.createErr
+	}
+	comment.ID = "1"
+	m.comments = append(m.comments, *comment)
+	return comment.ID, nil
+}
+
+func (m *mockCommentRepo) Save(_ context.Context, _, _ string, comment *domain.Comment) error {
+	m.saved = comment
+	return m.saveErr
+}
+
+func (m *mockCommentRepo) Delete(_ context.Context, _, _, commentID string) error {
+	m.deletedID = commentID
+	return nil
+}
+
+func TestCommentUseCase_List(t *testing.T) {
+	commentRepo := &mockCommentRepo{comments: []domain.Comment{{ID: "1", Author: "alice", Body: "hi"}}}
+	cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1"}}
+	uc := usecase.NewCommentUseCase(commentRepo, cardRepo)
+
+	got, err := uc.List(context.Background(), "board-1", "card-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 {
+		t.Errorf("got %d comments, want 1", len(got))
+	}
+
+	_, err = uc.List(context.Background(), "board-1", "missing")
+	var nf *domain.ErrNotFound
+	if !errors.As(err, &nf) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCommentUseCase_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		cardID    string
+		comment   *domain.Comment
+		wantErr   bool
+		checkType func(error) bool
+	}{
+		{
+			name:    "success",
+			cardID:  "card-1",
+			comment: &domain.Comment{Author: "alice", Body: "hi"},
+		},
+		{
+			name:    "missing body",
+			cardID:  "card-1",
+			comment: &domain.Comment{Author: "alice"},
+			wantErr: true,
+			checkType: func(err error) bool {
+				var ve *domain.ErrValidation
+				return errors.As(err, &ve)
+			},
+		},
+		{
+			name:    "card not found",
+			cardID:  "missing",
+			comment: &domain.Comment{Author: "alice", Body: "hi"},
+			wantErr: true,
+			checkType: func(err error) bool {
+				var nf *domain.ErrNotFound
+				return errors.As(err, &nf)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commentRepo := &mockCommentRepo{}
+			cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1"}}
+			uc := usecase.NewCommentUseCase(commentRepo, cardRepo)
+
+			got, err := uc.Create(context.Background(), "board-1", tt.cardID, tt.comment)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+					return
+				}
+				if tt.checkType != nil && !tt.checkType(err) {
+					t.Errorf("unexpected error type: %T", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID == "" || got.CreatedAt.IsZero() {
+				t.Errorf("expected ID and CreatedAt to be set, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestCommentUseCase_Update(t *testing.T) {
+	commentRepo := &mockCommentRepo{comments: []domain.Comment{{ID: "1", Author: "alice", Body: "hi"}}}
+	uc := usecase.NewCommentUseCase(commentRepo, &mockCardRepo{})
+
+	got, err := uc.Update(context.Background(), "board-1", "card-1", "1", "edited")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Body != "edited" || got.Author != "alice" {
+		t.Errorf("got %+v, want body edited by alice", got)
+	}
+
+	if _, err := uc.Update(context.Background(), "board-1", "card-1", "1", ""); err == nil {
+		t.Error("expected validation error for empty body")
+	}
+	if _, err := uc.Update(context.Background(), "board-1", "card-1", "missing", "x"); err == nil {
+		t.Error("expected not found error")
+	}
+}
+
+func TestCommentUseCase_Delete(t *testing.T) {
+	commentRepo := &mockCommentRepo{comments: []domain.Comment{{ID: "1", Author: "alice", Body: "hi"}}}
+	uc := usecase.NewCommentUseCase(commentRepo, &mockCardRepo{})
+
+	if err := uc.Delete(context.Background(), "board-1", "card-1", "1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if commentRepo.deletedID != "1" {
+		t.Errorf("deleted = %q, want 1", commentRepo.deletedID)
+	}
+
+	err := uc.Delete(context.Background(), "board-1", "card-1", "missing")
+	var nf *domain.ErrNotFound
+	if !errors.As(err, &nf) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// AddDependency records that cardID is blocked by blocker. An empty blocker
+// board refers to boardID. Links to missing cards and links that would close
+// a cycle are rejected. Both boards stay locked from the cycle check until
+// the card is saved.
+func (uc *CardUseCase) AddDependency(ctx context.Context, boardID, cardID string, blocker domain.CardRef) (*domain.Card, error) {
+	if blocker.Board == "" {
+		blocker.Board = boardID
+	}
+	if blocker.ID == "" {
+		return nil, &domain.ErrValidation{Field: "id", Message: "is required"}
+	}
+	defer uc.locks.lock(boardID, blocker.Board)()
+
+	card, err := uc.cardRepo.Get(ctx, boardID, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	self := domain.CardRef{Board: boardID, ID: cardID}
+	if blocker == self {
+		return nil, &domain.ErrValidation{Field: "blocked_by", Message: "card cannot block itself"}
+	}
+	if _, err := uc.cardRepo.Get(ctx, blocker.Board, blocker.ID); err != nil {
+		return nil, err
+	}
+	if card.IsBlockedBy(blocker) {
+		return card, nil
+	}
+
+	cycle, err := uc.dependsOn(ctx, blocker, self)
+	if err != nil {
+		return nil, err
+	}
+	if cycle {
+		return nil, &domain.ErrValidation{
+			Field:   "blocked_by",
+			Message: "card " + blocker.Board + "/" + blocker.ID + " already depends on this card",
+		}
+	}
+
+	card.BlockedBy = append(card.BlockedBy, blocker)
+	card.UpdatedAt = time.Now()
+
+	if err := uc.cardRepo.Save(ctx, boardID, card); err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+func (uc *CardUseCase) RemoveDependency(ctx context.Context, boardID, cardID string, blocker domain.CardRef) (*domain.Card, error) {
+	if blocker.Board == "" {
+		blocker.Board = boardID
+	}
+
+	return uc.modifyCard(ctx, boardID, cardID, func(card *domain.Card) error {
+		if !card.IsBlockedBy(blocker) {
+			return &domain.ErrNotFound{Resource: "dependency", ID: blocker.Board + "/" + blocker.ID}
+		}
+		kept := make([]domain.CardRef, 0, len(card.BlockedBy)-1)
+		for _, r := range card.BlockedBy {
+			if r != blocker {
+				kept = append(kept, r)
+			}
+		}
+		card.BlockedBy = kept
+		return nil
+	})
+}
+
+// DependencyGraph returns the blocked-by links touching active cards on boardID,
+// including links to and from cards on other boards.
+func (uc *CardUseCase) DependencyGraph(ctx context.Context, boardID string) (*domain.DependencyGraph, error) {
+	if _, err := uc.boardRepo.Get(ctx, boardID); err != nil {
+		return nil, err
+	}
+
+	boards, err := uc.boardRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	boardsByID := make(map[string]*domain.Board, len(boards))
+	cards := make(map[domain.CardRef]*domain.Card)
+	var refs []domain.CardRef
+	for i := range boards {
+		boardsByID[boards[i].ID] = &boards[i]
+		boardCards, err := uc.cardRepo.ListByBoard(ctx, boards[i].ID, true)
+		if err != nil {
+			return nil, err
+		}
+		for j := range boardCards {
+			ref := domain.CardRef{Board: boards[i].ID, ID: boardCards[j].ID}
+			cards[ref] = &boardCards[j]
+			refs = append(refs, ref)
+		}
+	}
+
+	graph := &domain.DependencyGraph{
+		BoardID: boardID,
+		Nodes:   []domain.DependencyNode{},
+		Edges:   []domain.DependencyEdge{},
+	}
+	seen := make(map[domain.CardRef]bool)
+	addNode := func(ref domain.CardRef) {
+		if seen[ref] {
+			return
+		}
+		card, ok := cards[ref]
+		if !ok {
+			return
+		}
+		seen[ref] = true
+		graph.Nodes = append(graph.Nodes, domain.DependencyNode{
+			CardRef:  ref,
+			Title:    card.Title,
+			List:     card.List,
+			Resolved: isResolved(card, boardsByID[ref.Board]),
+		})
+	}
+
+	for _, ref := range refs {
+		if ref.Board == boardID && !cards[ref].Archived {
+			addNode(ref)
+		}
+	}
+	for _, ref := range refs {
+		for _, blocker := range cards[ref].BlockedBy {
+			if ref.Board != boardID && blocker.Board != boardID {
+				continue
+			}
+			if _, ok := cards[blocker]; !ok {
+				continue
+			}
+			addNode(blocker)
+			addNode(ref)
+			graph.Edges = append(graph.Edges, domain.DependencyEdge{From: blocker, To: ref})
+		}
+	}
+	return graph, nil
+}
+
+// dependsOn reports whether from is transitively blocked by target.
+func (uc *CardUseCase) dependsOn(ctx context.Context, from, target domain.CardRef) (bool, error) {
+	visited := map[domain.CardRef]bool{from: true}
+	queue := []domain.CardRef{from}
+	for len(queue) > 0 {
+		ref := queue[0]
+		queue = queue[1:]
+
+		card, err := uc.cardRepo.Get(ctx, ref.Board, ref.ID)
+		if err != nil {
+			var notFound *domain.ErrNotFound
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return false, err
+		}
+		for _, next := range card.BlockedBy {
+			if next == target {
+				return true, nil
+			}
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false, nil
+}
+
+// checkUnblocked returns a validation error when card still has blockers that
+// are neither archived nor in a done list of their board. Blockers that no
+// longer exist are ignored.
+func (uc *CardUseCase) checkUnblocked(ctx context.Context, board *domain.Board, card *domain.Card) error {
+	boards := map[string]*domain.Board{board.ID: board}
+	var pending []string
+	for _, ref := range card.BlockedBy {
+		blocker, err := uc.cardRepo.Get(ctx, ref.Board, ref.ID)
+		if err != nil {
+			var notFound *domain.ErrNotFound
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return err
+		}
+		b, ok := boards[ref.Board]
+		if !ok {
+			b, err = uc.boardRepo.Get(ctx, ref.Board)
+			if err != nil {
+				var notFound *domain.ErrNotFound
+				if errors.As(err, &notFound) {
+					continue
+				}
+				return err
+			}
+			boards[ref.Board] = b
+		}
+		if !isResolved(blocker, b) {
+			pending = append(pending, ref.Board+"/"+ref.ID)
+		}
+	}
+	if len(pending) > 0 {
+		return &domain.ErrValidation{
+			Field:   "blocked_by",
+			Message: "card is blocked by " + strings.Join(pending, ", "),
+		}
+	}
+	return nil
+}
+
+func isResolved(card *domain.Card, board *domain.Board) bool {
+	if card.Archived {
+		return true
+	}
+	return board != nil && board.IsDoneList(card.List)
+}
@@ -0,0 +1,185 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+func dependencyBoard() *domain.Board {
+	return &domain.Board{ID: "board-1", Name: "Board", Lists: []domain.List{
+		{ID: "todo", Name: "Todo"},
+		{ID: "done", Name: "Done", Done: true},
+	}}
+}
+
+func TestCardUseCase_AddDependency(t *testing.T) {
+	tests := []struct {
+		name      string
+		cardID    string
+		blocker   domain.CardRef
+		cards     []domain.Card
+		wantErr   bool
+		checkType func(error) bool
+	}{
+		{
+			name:    "success",
+			cardID:  "a",
+			blocker: domain.CardRef{ID: "b"},
+			cards:   []domain.Card{{ID: "a", List: "todo"}, {ID: "b", List: "todo"}},
+		},
+		{
+			name:    "self reference",
+			cardID:  "a",
+			blocker: domain.CardRef{ID: "a"},
+			cards:   []domain.Card{{ID: "a", List: "todo"}},
+			wantErr: true,
+			checkType: func(err error) bool {
+				var ve *domain.ErrValidation
+				return errors.As(err, &ve)
+			},
+		},
+		{
+			name:    "blocker not found",
+			cardID:  "a",
+			blocker: domain.CardRef{ID: "missing"},
+			cards:   []domain.Card{{ID: "a", List: "todo"}},
+			wantErr: true,
+			checkType: func(err error) bool {
+				var nf *domain.ErrNotFound
+				return errors.As(err, &nf)
+			},
+		},
+		{
+			name:    "cycle",
+			cardID:  "a",
+			blocker: domain.CardRef{ID: "b"},
+			cards: []domain.Card{
+				{ID: "a", List: "todo"},
+				{ID: "b", List: "todo", BlockedBy: []domain.CardRef{{Board: "board-1", ID: "c"}}},
+				{ID: "c", List: "todo", BlockedBy: []domain.CardRef{{Board: "board-1", ID: "a"}}},
+			},
+			wantErr: true,
+			checkType: func(err error) bool {
+				var ve *domain.ErrValidation
+				return errors.As(err, &ve)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardRepo := &mockCardRepo{cards: tt.cards}
+			boardRepo := &mockBoardRepo{board: dependencyBoard()}
+			uc := usecase.NewCardUseCase(cardRepo, boardRepo)
+
+			got, err := uc.AddDependency(context.Background(), "board-1", tt.cardID, tt.blocker)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+					return
+				}
+				if tt.checkType != nil && !tt.checkType(err) {
+					t.Errorf("unexpected error type: %T", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := domain.CardRef{Board: "board-1", ID: tt.blocker.ID}
+			if !got.IsBlockedBy(want) {
+				t.Errorf("BlockedBy = %v, want to contain %v", got.BlockedBy, want)
+			}
+		})
+	}
+}
+
+func TestCardUseCase_RemoveDependency(t *testing.T) {
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "a", List: "todo", BlockedBy: []domain.CardRef{{Board: "board-1", ID: "b"}}},
+		{ID: "b", List: "todo"},
+	}}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: dependencyBoard()})
+
+	got, err := uc.RemoveDependency(context.Background(), "board-1", "a", domain.CardRef{ID: "b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.BlockedBy) != 0 {
+		t.Errorf("BlockedBy = %v, want empty", got.BlockedBy)
+	}
+
+	_, err = uc.RemoveDependency(context.Background(), "board-1", "a", domain.CardRef{ID: "b"})
+	var nf *domain.ErrNotFound
+	if !errors.As(err, &nf) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCardUseCase_DependencyGraph(t *testing.T) {
+	board := dependencyBoard()
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "a", Title: "A", List: "todo", BlockedBy: []domain.CardRef{{Board: "board-1", ID: "b"}}},
+		{ID: "b", Title: "B", List: "done"},
+		{ID: "c", Title: "C", List: "todo"},
+	}}
+	boardRepo := &mockBoardRepo{board: board, boards: []domain.Board{*board}}
+	uc := usecase.NewCardUseCase(cardRepo, boardRepo)
+
+	got, err := uc.DependencyGraph(context.Background(), "board-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.Nodes) != 3 {
+		t.Errorf("got %d nodes, want 3", len(got.Nodes))
+	}
+	if len(got.Edges) != 1 {
+		t.Fatalf("got %d edges, want 1", len(got.Edges))
+	}
+	if got.Edges[0].From.ID != "b" || got.Edges[0].To.ID != "a" {
+		t.Errorf("edge = %v, want b -> a", got.Edges[0])
+	}
+	for _, n := range got.Nodes {
+		if n.ID == "b" && !n.Resolved {
+			t.Error("card in done list should be resolved")
+		}
+	}
+}
+
+func TestCardUseCase_Move_Blocked(t *testing.T) {
+	tests := []struct {
+		name    string
+		blocker domain.Card
+		wantErr bool
+	}{
+		{name: "unresolved blocker", blocker: domain.Card{ID: "b", List: "todo"}, wantErr: true},
+		{name: "blocker done", blocker: domain.Card{ID: "b", List: "done"}},
+		{name: "blocker archived", blocker: domain.Card{ID: "b", List: "todo", Archived: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardRepo := &mockCardRepo{cards: []domain.Card{
+				{ID: "a", Title: "A", List: "todo", BlockedBy: []domain.CardRef{{Board: "board-1", ID: "b"}}},
+				tt.blocker,
+			}}
+			uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: dependencyBoard()})
+
+			_, err := uc.Move(context.Background(), "board-1", "a", "done", 0)
+			if tt.wantErr {
+				var ve *domain.ErrValidation
+				if !errors.As(err, &ve) {
+					t.Errorf("expected ErrValidation, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
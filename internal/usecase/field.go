@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"maps"
+	"strconv"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// mergeFields applies updates to the current custom field values. A nil
+// update value removes the field. The result is validated against the board.
+func mergeFields(board *domain.Board, current, updates map[string]any) (map[string]any, error) {
+	fields := maps.Clone(current)
+	if fields == nil {
+		fields = make(map[string]any, len(updates))
+	}
+	for id, v := range updates {
+		if v == nil {
+			delete(fields, id)
+			continue
+		}
+		fields[id] = v
+	}
+
+	if err := board.ValidateFieldValues(fields); err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+func matchFields(values map[string]any, want map[string]string) bool {
+	for id, w := range want {
+		if !fieldValueMatches(values[id], w) {
+			return false
+		}
+	}
+	return true
+}
+
+func fieldValueMatches(v any, want string) bool {
+	switch val := v.(type) {
+	case nil:
+		return false
+	case string:
+		return val == want
+	case bool:
+		b, err := strconv.ParseBool(want)
+		return err == nil && b == val
+	case time.Time:
+		return val.Format(domain.FieldDateLayout) == want
+	case int:
+		return numberMatches(float64(val), want)
+	case int64:
+		return numberMatches(float64(val), want)
+	case uint64:
+		return numberMatches(float64(val), want)
+	case float64:
+		return numberMatches(val, want)
+	default:
+		return false
+	}
+}
+
+func numberMatches(v float64, want string) bool {
+	f, err := strconv.ParseFloat(want, 64)
+	return err == nil && f == v
+}
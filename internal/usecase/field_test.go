@@ -0,0 +1,121 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+func fieldBoard() *domain.Board {
+	return &domain.Board{
+		ID:    "board-1",
+		Lists: []domain.List{{ID: "todo", Name: "Todo"}},
+		Fields: []domain.FieldDef{
+			{ID: "severity", Name: "Severity", Type: domain.FieldEnum, Options: []string{"low", "high"}},
+			{ID: "points", Name: "Points", Type: domain.FieldNumber},
+			{ID: "flaky", Name: "Flaky", Type: domain.FieldBool},
+		},
+	}
+}
+
+func TestCardUseCase_Create_Fields(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  map[string]any
+		wantErr bool
+	}{
+		{name: "valid", fields: map[string]any{"severity": "high", "points": 3.0}},
+		{name: "undefined field", fields: map[string]any{"channel": "blog"}, wantErr: true},
+		{name: "invalid enum", fields: map[string]any{"severity": "urgent"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardRepo := &mockCardRepo{nextID: "20260124-001"}
+			uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: fieldBoard()})
+
+			_, err := uc.Create(context.Background(), "board-1", &domain.Card{Title: "Bug", List: "todo", Fields: tt.fields})
+			if tt.wantErr {
+				var ve *domain.ErrValidation
+				if !errors.As(err, &ve) {
+					t.Errorf("expected ErrValidation, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCardUseCase_Update_Fields(t *testing.T) {
+	cardRepo := &mockCardRepo{card: &domain.Card{
+		ID: "card-1", Title: "Bug", List: "todo",
+		Fields: map[string]any{"severity": "low", "points": 2},
+	}}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: fieldBoard()})
+
+	got, err := uc.Update(context.Background(), "board-1", "card-1", &domain.Card{
+		Fields: map[string]any{"severity": "high", "points": nil},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Fields["severity"] != "high" {
+		t.Errorf("severity = %v, want high", got.Fields["severity"])
+	}
+	if _, ok := got.Fields["points"]; ok {
+		t.Error("points should be removed by a null update")
+	}
+
+	_, err = uc.Update(context.Background(), "board-1", "card-1", &domain.Card{
+		Fields: map[string]any{"flaky": "yes"},
+	})
+	var ve *domain.ErrValidation
+	if !errors.As(err, &ve) {
+		t.Errorf("expected ErrValidation, got %v", err)
+	}
+}
+
+func TestCardUseCase_List_FieldFilter(t *testing.T) {
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "1", Fields: map[string]any{"severity": "high", "points": 3, "flaky": true}},
+		{ID: "2", Fields: map[string]any{"severity": "low", "points": 3.0}},
+		{ID: "3"},
+	}}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: fieldBoard()})
+
+	tests := []struct {
+		name   string
+		fields map[string]string
+		want   int
+	}{
+		{"enum", map[string]string{"severity": "high"}, 1},
+		{"number across int and float", map[string]string{"points": "3"}, 2},
+		{"bool", map[string]string{"flaky": "true"}, 1},
+		{"combined", map[string]string{"severity": "low", "points": "3"}, 1},
+		{"no match", map[string]string{"severity": "medium"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uc.List(context.Background(), "board-1", usecase.CardFilter{Fields: tt.fields})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("got %d cards, want %d", len(got), tt.want)
+			}
+		})
+	}
+
+	_, err := uc.List(context.Background(), "board-1", usecase.CardFilter{Fields: map[string]string{"channel": "blog"}})
+	var ve *domain.ErrValidation
+	if !errors.As(err, &ve) {
+		t.Errorf("expected ErrValidation for undefined field, got %v", err)
+	}
+}
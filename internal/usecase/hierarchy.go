@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// Children returns the direct subtasks of cardID, including archived ones.
+func (uc *CardUseCase) Children(ctx context.Context, boardID, cardID string) ([]domain.Card, error) {
+	if _, err := uc.cardRepo.Get(ctx, boardID, cardID); err != nil {
+		return nil, err
+	}
+
+	cards, err := uc.cardRepo.ListByBoard(ctx, boardID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	children := []domain.Card{}
+	for _, c := range cards {
+		if c.Parent == cardID {
+			children = append(children, c)
+		}
+	}
+	return children, nil
+}
+
+// Progress rolls up the completion of cardID's direct subtasks together with
+// the todo items of the card and its subtasks. A subtask counts as done when
+// it is archived or sits in a done list.
+func (uc *CardUseCase) Progress(ctx context.Context, boardID, cardID string) (*domain.CardProgress, error) {
+	board, err := uc.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	card, err := uc.cardRepo.Get(ctx, boardID, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := uc.Children(ctx, boardID, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &domain.CardProgress{
+		ChildrenTotal: len(children),
+		TodosDone:     card.CompletedTodos(),
+		TodosTotal:    len(card.Todos),
+	}
+	for i := range children {
+		if isResolved(&children[i], board) {
+			progress.ChildrenDone++
+		}
+		progress.TodosDone += children[i].CompletedTodos()
+		progress.TodosTotal += len(children[i].Todos)
+	}
+	return progress, nil
+}
+
+// SetParent makes cardID a subtask of parentID. An empty parentID detaches the
+// card. The board stays locked from the cycle check until the card is saved.
+func (uc *CardUseCase) SetParent(ctx context.Context, boardID, cardID, parentID string) (*domain.Card, error) {
+	defer uc.locks.lock(boardID)()
+
+	card, err := uc.cardRepo.Get(ctx, boardID, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID != "" {
+		if parentID == cardID {
+			return nil, &domain.ErrValidation{Field: "parent", Message: "card cannot be its own parent"}
+		}
+		// Walk up from the new parent; reaching cardID means the card would
+		// become an ancestor of itself.
+		seen := map[string]bool{}
+		for id := parentID; id != "" && !seen[id]; {
+			seen[id] = true
+			ancestor, err := uc.cardRepo.Get(ctx, boardID, id)
+			if err != nil {
+				return nil, err
+			}
+			if ancestor.Parent == cardID {
+				return nil, &domain.ErrValidation{Field: "parent", Message: "card " + parentID + " is a subtask of this card"}
+			}
+			id = ancestor.Parent
+		}
+	}
+
+	card.Parent = parentID
+	card.UpdatedAt = time.Now()
+
+	if err := uc.cardRepo.Save(ctx, boardID, card); err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+// archiveDescendants archives every active subtask below cardID and returns
+// them for saving.
+func (uc *CardUseCase) archiveDescendants(ctx context.Context, boardID, cardID string, now time.Time) ([]domain.CardWrite, error) {
+	cards, err := uc.cardRepo.ListByBoard(ctx, boardID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	byParent := make(map[string][]int)
+	for i, c := range cards {
+		if c.Parent != "" {
+			byParent[c.Parent] = append(byParent[c.Parent], i)
+		}
+	}
+
+	var writes []domain.CardWrite
+	queue := []string{cardID}
+	visited := map[string]bool{cardID: true}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, i := range byParent[id] {
+			child := &cards[i]
+			if visited[child.ID] {
+				continue
+			}
+			visited[child.ID] = true
+			child.Archived = true
+			child.UpdatedAt = now
+			writes = append(writes, domain.CardWrite{BoardID: boardID, Card: child})
+			queue = append(queue, child.ID)
+		}
+	}
+	return writes, nil
+}
+
+// detachChildren clears the parent reference of cardID's direct subtasks.
+func (uc *CardUseCase) detachChildren(ctx context.Context, boardID, cardID string) error {
+	cards, err := uc.cardRepo.ListByBoard(ctx, boardID, true)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var writes []domain.CardWrite
+	for i := range cards {
+		if cards[i].Parent != cardID {
+			continue
+		}
+		cards[i].Parent = ""
+		cards[i].UpdatedAt = now
+		writes = append(writes, domain.CardWrite{BoardID: boardID, Card: &cards[i]})
+	}
+	return uc.cardRepo.SaveAll(ctx, writes)
+}
@@ -0,0 +1,127 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+func TestCardUseCase_Children(t *testing.T) {
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "epic", List: "todo"},
+		{ID: "sub-1", List: "todo", Parent: "epic"},
+		{ID: "sub-2", List: "done", Parent: "epic", Archived: true},
+		{ID: "other", List: "todo"},
+	}}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+	got, err := uc.Children(context.Background(), "board-1", "epic")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("got %d children, want 2", len(got))
+	}
+}
+
+func TestCardUseCase_Progress(t *testing.T) {
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "epic", List: "todo", Todos: []domain.TodoItem{{ID: "1", Text: "a", Completed: true}}},
+		{ID: "sub-1", List: "done", Parent: "epic"},
+		{ID: "sub-2", List: "todo", Parent: "epic", Todos: []domain.TodoItem{
+			{ID: "1", Text: "b", Completed: true},
+			{ID: "2", Text: "c"},
+		}},
+	}}
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1", Lists: []domain.List{
+		{ID: "todo", Name: "Todo"},
+		{ID: "done", Name: "Done", Done: true},
+	}}}
+	uc := usecase.NewCardUseCase(cardRepo, boardRepo)
+
+	got, err := uc.Progress(context.Background(), "board-1", "epic")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := domain.CardProgress{ChildrenDone: 1, ChildrenTotal: 2, TodosDone: 2, TodosTotal: 3}
+	if *got != want {
+		t.Errorf("Progress = %+v, want %+v", *got, want)
+	}
+}
+
+func TestCardUseCase_SetParent(t *testing.T) {
+	tests := []struct {
+		name     string
+		cardID   string
+		parentID string
+		wantErr  bool
+	}{
+		{name: "success", cardID: "b", parentID: "a"},
+		{name: "detach", cardID: "c", parentID: ""},
+		{name: "self", cardID: "a", parentID: "a", wantErr: true},
+		{name: "cycle", cardID: "a", parentID: "c", wantErr: true},
+		{name: "parent not found", cardID: "a", parentID: "missing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardRepo := &mockCardRepo{cards: []domain.Card{
+				{ID: "a", List: "todo"},
+				{ID: "b", List: "todo"},
+				{ID: "c", List: "todo", Parent: "a"},
+			}}
+			uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+			got, err := uc.SetParent(context.Background(), "board-1", tt.cardID, tt.parentID)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Parent != tt.parentID {
+				t.Errorf("Parent = %q, want %q", got.Parent, tt.parentID)
+			}
+		})
+	}
+}
+
+func TestCardUseCase_Archive_CascadesToChildren(t *testing.T) {
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "epic", List: "todo"},
+		{ID: "sub", List: "todo", Parent: "epic"},
+		{ID: "subsub", List: "todo", Parent: "sub"},
+		{ID: "other", List: "todo"},
+	}}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+	if _, err := uc.Archive(context.Background(), "board-1", "epic", true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, c := range cardRepo.cards {
+		want := c.ID != "other"
+		if c.Archived != want {
+			t.Errorf("%s Archived = %v, want %v", c.ID, c.Archived, want)
+		}
+	}
+}
+
+func TestCardUseCase_Create_ParentNotFound(t *testing.T) {
+	cardRepo := &mockCardRepo{nextID: "20260124-001"}
+	boardRepo := &mockBoardRepo{
+		board: &domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}},
+	}
+	uc := usecase.NewCardUseCase(cardRepo, boardRepo)
+
+	_, err := uc.Create(context.Background(), "board-1", &domain.Card{Title: "Sub", List: "todo", Parent: "missing"})
+	var nf *domain.ErrNotFound
+	if !errors.As(err, &nf) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
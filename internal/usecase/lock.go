@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"slices"
+	"sync"
+)
+
+// boardLocks serializes read-modify-write sequences on a board, such as ranking
+// a card against its neighbours and saving it. The repository's own locking
+// only covers single calls.
+type boardLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock locks the given boards in ID order, so that operations spanning the
+// same boards cannot deadlock, and returns the unlock function.
+func (l *boardLocks) lock(boardIDs ...string) func() {
+	ids := slices.Compact(slices.Sorted(slices.Values(boardIDs)))
+
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*sync.Mutex)
+	}
+	locked := make([]*sync.Mutex, len(ids))
+	for i, id := range ids {
+		m, ok := l.locks[id]
+		if !ok {
+			m = &sync.Mutex{}
+			l.locks[id] = m
+		}
+		locked[i] = m
+	}
+	l.mu.Unlock()
+
+	for _, m := range locked {
+		m.Lock()
+	}
+	return func() {
+		for i := len(locked) - 1; i >= 0; i-- {
+			locked[i].Unlock()
+		}
+	}
+}
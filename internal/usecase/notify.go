@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// ChangeNotifier is told about the boards and cards written through the
+// notifying repositories below. It stands in for the file watcher when the
+// storage is not made of watched files, as with SQLite.
+type ChangeNotifier interface {
+	BoardChanged(boardID string)
+	CardChanged(boardID, cardID string)
+}
+
+// NotifyingBoardRepository wraps repo so that saved and deleted boards are
+// reported to n.
+func NotifyingBoardRepository(repo domain.BoardRepository, n ChangeNotifier) domain.BoardRepository {
+	return &notifyingBoardRepo{BoardRepository: repo, n: n}
+}
+
+type notifyingBoardRepo struct {
+	domain.BoardRepository
+	n ChangeNotifier
+}
+
+func (r *notifyingBoardRepo) Save(ctx context.Context, board *domain.Board) error {
+	if err := r.BoardRepository.Save(ctx, board); err != nil {
+		return err
+	}
+	r.n.BoardChanged(board.ID)
+	return nil
+}
+
+func (r *notifyingBoardRepo) Delete(ctx context.Context, id string) error {
+	if err := r.BoardRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.n.BoardChanged(id)
+	return nil
+}
+
+// NotifyingCardRepository wraps repo so that every card it writes or
+// deletes is reported to n.
+func NotifyingCardRepository(repo domain.CardRepository, n ChangeNotifier) domain.CardRepository {
+	return &notifyingCardRepo{CardRepository: repo, n: n}
+}
+
+type notifyingCardRepo struct {
+	domain.CardRepository
+	n ChangeNotifier
+}
+
+func (r *notifyingCardRepo) Save(ctx context.Context, boardID string, card *domain.Card) error {
+	if err := r.CardRepository.Save(ctx, boardID, card); err != nil {
+		return err
+	}
+	r.n.CardChanged(boardID, card.ID)
+	return nil
+}
+
+func (r *notifyingCardRepo) SaveAll(ctx context.Context, writes []domain.CardWrite) error {
+	if err := r.CardRepository.SaveAll(ctx, writes); err != nil {
+		return err
+	}
+	for _, w := range writes {
+		r.n.CardChanged(w.BoardID, w.Card.ID)
+	}
+	return nil
+}
+
+func (r *notifyingCardRepo) Delete(ctx context.Context, boardID, cardID string) error {
+	if err := r.CardRepository.Delete(ctx, boardID, cardID); err != nil {
+		return err
+	}
+	r.n.CardChanged(boardID, cardID)
+	return nil
+}
+
+func (r *notifyingCardRepo) Create(ctx context.Context, boardID string, card *domain.Card) (string, error) {
+	id, err := r.CardRepository.Create(ctx, boardID, card)
+	if err != nil {
+		return "", err
+	}
+	r.n.CardChanged(boardID, id)
+	return id, nil
+}
+
+func (r *notifyingCardRepo) Transfer(ctx context.Context, fromBoardID, toBoardID string, card *domain.Card) (string, error) {
+	oldID := card.ID
+	id, err := r.CardRepository.Transfer(ctx, fromBoardID, toBoardID, card)
+	if err != nil {
+		return "", err
+	}
+	r.n.CardChanged(fromBoardID, oldID)
+	r.n.CardChanged(toBoardID, id)
+	return id, nil
+}
+
+// NotifyingTrashRepository wraps repo so that restored boards and cards are
+// reported to n.
+func NotifyingTrashRepository(repo domain.TrashRepository, n ChangeNotifier) domain.TrashRepository {
+	return &notifyingTrashRepo{TrashRepository: repo, n: n}
+}
+
+type notifyingTrashRepo struct {
+	domain.TrashRepository
+	n ChangeNotifier
+}
+
+func (r *notifyingTrashRepo) Restore(ctx context.Context, itemID string) (*domain.TrashItem, error) {
+	item, err := r.TrashRepository.Restore(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.Kind == domain.TrashCard {
+		r.n.CardChanged(item.BoardID, item.CardID)
+	} else {
+		r.n.BoardChanged(item.BoardID)
+	}
+	return item, nil
+}
@@ -0,0 +1,64 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/memory"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type recordingNotifier struct {
+	changes []string
+}
+
+func (n *recordingNotifier) BoardChanged(boardID string) {
+	n.changes = append(n.changes, boardID)
+}
+
+func (n *recordingNotifier) CardChanged(boardID, cardID string) {
+	n.changes = append(n.changes, boardID+"/"+cardID)
+}
+
+func TestNotifyingRepositories(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	n := &recordingNotifier{}
+	boards := usecase.NotifyingBoardRepository(store, n)
+	cardUC := usecase.NewCardUseCase(usecase.NotifyingCardRepository(memory.NewCardRepositoryAdapter(store), n), boards)
+
+	lists := []domain.List{{ID: "todo", Name: "Todo"}}
+	for _, id := range []string{"board-1", "board-2"} {
+		if err := boards.Save(ctx, &domain.Board{ID: id, Name: id, Lists: lists}); err != nil {
+			t.Fatalf("Save board: %v", err)
+		}
+	}
+	card, err := cardUC.Create(ctx, "board-1", &domain.Card{Title: "A", List: "todo"})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if _, err := cardUC.AddTodo(ctx, "board-1", card.ID, "step"); err != nil {
+		t.Fatalf("AddTodo: %v", err)
+	}
+	moved, err := cardUC.MoveToBoard(ctx, "board-1", card.ID, "board-2", "todo", 0)
+	if err != nil {
+		t.Fatalf("MoveToBoard: %v", err)
+	}
+	if err := cardUC.Delete(ctx, "board-2", moved.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if !slices.Equal(n.changes[:3], []string{"board-1", "board-2", "board-1/" + card.ID}) {
+		t.Errorf("changes after create = %v", n.changes[:3])
+	}
+	for _, want := range []string{"board-1/" + card.ID, "board-2/" + moved.ID} {
+		if !slices.Contains(n.changes[3:], want) {
+			t.Errorf("changes = %v, want %s after the create", n.changes, want)
+		}
+	}
+	if last := n.changes[len(n.changes)-1]; last != "board-2/"+moved.ID {
+		t.Errorf("last change = %s, want the deleted card", last)
+	}
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+type RecurringUseCase struct {
+	recurringRepo domain.RecurringRepository
+	boardRepo     domain.BoardRepository
+	cardUC        *CardUseCase
+}
+
+func NewRecurringUseCase(recurringRepo domain.RecurringRepository, boardRepo domain.BoardRepository, cardUC *CardUseCase) *RecurringUseCase {
+	return &RecurringUseCase{recurringRepo: recurringRepo, boardRepo: boardRepo, cardUC: cardUC}
+}
+
+// List returns the board's recurring templates with NextRun filled in.
+func (uc *RecurringUseCase) List(ctx context.Context, boardID string) ([]domain.RecurringCard, error) {
+	if _, err := uc.boardRepo.Get(ctx, boardID); err != nil {
+		return nil, err
+	}
+
+	items, err := uc.recurringRepo.ListByBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].NextRun = nextRun(&items[i])
+	}
+	return items, nil
+}
+
+// Save creates or replaces a recurring template. LastRun is kept from the
+// stored template so that editing a rule does not re-create past occurrences.
+func (uc *RecurringUseCase) Save(ctx context.Context, boardID string, rc *domain.RecurringCard) (*domain.RecurringCard, error) {
+	board, err := uc.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rc.Validate(); err != nil {
+		return nil, err
+	}
+	if !board.HasList(rc.List) {
+		return nil, &domain.ErrValidation{
+			Field:   "list",
+			Message: "list '" + rc.List + "' does not exist in board",
+		}
+	}
+
+	items, err := uc.recurringRepo.ListByBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	rc.LastRun = time.Time{}
+	for _, existing := range items {
+		if existing.ID == rc.ID {
+			rc.LastRun = existing.LastRun
+			break
+		}
+	}
+
+	if err := uc.recurringRepo.Save(ctx, boardID, rc); err != nil {
+		return nil, err
+	}
+	rc.NextRun = nextRun(rc)
+	return rc, nil
+}
+
+// RunDue creates a card for every template whose latest occurrence is due at now.
+// Created cards carry a recurrence key, so an occurrence is never materialized
+// twice even if recording LastRun failed on a previous run.
+func (uc *RecurringUseCase) RunDue(ctx context.Context, now time.Time) error {
+	boards, err := uc.boardRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, board := range boards {
+		if board.Archived {
+			continue
+		}
+		if err := uc.runBoard(ctx, board.ID, now); err != nil {
+			errs = append(errs, fmt.Errorf("board %s: %w", board.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (uc *RecurringUseCase) runBoard(ctx context.Context, boardID string, now time.Time) error {
+	items, err := uc.recurringRepo.ListByBoard(ctx, boardID)
+	if err != nil {
+		return err
+	}
+
+	var existing map[string]bool
+	var errs []error
+	for i := range items {
+		rc := &items[i]
+		if err := rc.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("recurring %s: %w", rc.ID, err))
+			continue
+		}
+		occ, due := rc.Due(now)
+		if !due {
+			continue
+		}
+
+		if existing == nil {
+			existing, err = uc.recurrenceKeys(ctx, boardID)
+			if err != nil {
+				return err
+			}
+		}
+
+		key := recurrenceKey(rc.ID, occ)
+		if !existing[key] {
+			card := &domain.Card{
+				Title:       rc.Title,
+				List:        rc.List,
+				Description: rc.Description,
+				Labels:      rc.Labels,
+				Todos:       cloneTodos(rc.Todos),
+				Recurrence:  key,
+			}
+			if _, err := uc.cardUC.Create(ctx, boardID, card); err != nil {
+				errs = append(errs, fmt.Errorf("recurring %s: %w", rc.ID, err))
+				continue
+			}
+			existing[key] = true
+		}
+
+		rc.LastRun = occ
+		if err := uc.recurringRepo.Save(ctx, boardID, rc); err != nil {
+			errs = append(errs, fmt.Errorf("recurring %s: %w", rc.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (uc *RecurringUseCase) recurrenceKeys(ctx context.Context, boardID string) (map[string]bool, error) {
+	cards, err := uc.cardUC.List(ctx, boardID, CardFilter{IncludeArchived: true})
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]bool)
+	for _, c := range cards {
+		if c.Recurrence != "" {
+			keys[c.Recurrence] = true
+		}
+	}
+	return keys, nil
+}
+
+func recurrenceKey(templateID string, occ time.Time) string {
+	return templateID + "@" + occ.UTC().Format(time.RFC3339)
+}
+
+func nextRun(rc *domain.RecurringCard) time.Time {
+	r, err := domain.ParseRecurrence(rc.Rule)
+	if err != nil || rc.Start.IsZero() {
+		return time.Time{}
+	}
+	return r.Next(rc.Start, rc.LastRun)
+}
+
+// cloneTodos copies template todos without IDs so each card gets fresh ones.
+func cloneTodos(todos []domain.TodoItem) []domain.TodoItem {
+	if todos == nil {
+		return nil
+	}
+	out := make([]domain.TodoItem, len(todos))
+	for i, t := range todos {
+		out[i] = domain.TodoItem{Text: t.Text}
+	}
+	return out
+}
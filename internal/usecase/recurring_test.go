@@ -0,0 +1,128 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type mockRecurringRepo struct {
+	items   []domain.RecurringCard
+	saveErr error
+}
+
+func (m *mockRecurringRepo) ListByBoard(_ context.Context, _ string) ([]domain.RecurringCard, error) {
+	out := make([]domain.RecurringCard, len(m.items))
+	copy(out, m.items)
+	return out, nil
+}
+
+func (m *mockRecurringRepo) Save(_ context.Context, _ string, rc *domain.RecurringCard) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	for i := range m.items {
+		if m.items[i].ID == rc.ID {
+			m.items[i] = *rc
+			return nil
+		}
+	}
+	m.items = append(m.items, *rc)
+	return nil
+}
+
+func newRecurringFixture() (*mockCardRepo, *mockRecurringRepo, *usecase.RecurringUseCase) {
+	board := domain.Board{ID: "board-1", Name: "Board", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}
+	boardRepo := &mockBoardRepo{boards: []domain.Board{board}, board: &board}
+	cardRepo := &mockCardRepo{nextID: "20260119-001"}
+	recurringRepo := &mockRecurringRepo{items: []domain.RecurringCard{{
+		ID:    "weekly-report",
+		Rule:  "FREQ=WEEKLY;BYDAY=MO",
+		Start: time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		Title: "Weekly report",
+		List:  "todo",
+		Todos: []domain.TodoItem{{ID: "t1", Text: "Collect numbers"}},
+	}}}
+	cardUC := usecase.NewCardUseCase(cardRepo, boardRepo)
+	return cardRepo, recurringRepo, usecase.NewRecurringUseCase(recurringRepo, boardRepo, cardUC)
+}
+
+func TestRecurringUseCase_RunDue(t *testing.T) {
+	cardRepo, recurringRepo, uc := newRecurringFixture()
+	now := time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)
+
+	if err := uc.RunDue(context.Background(), now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	created := cardRepo.createdCard
+	if created == nil {
+		t.Fatal("expected a card to be created")
+	}
+	if created.Title != "Weekly report" || created.List != "todo" {
+		t.Errorf("created = %+v", created)
+	}
+	if want := "weekly-report@2026-01-19T09:00:00Z"; created.Recurrence != want {
+		t.Errorf("Recurrence = %s, want %s", created.Recurrence, want)
+	}
+	if len(created.Todos) != 1 || created.Todos[0].ID == "t1" {
+		t.Errorf("todos should be copied with fresh IDs: %+v", created.Todos)
+	}
+	if want := time.Date(2026, 1, 19, 9, 0, 0, 0, time.UTC); !recurringRepo.items[0].LastRun.Equal(want) {
+		t.Errorf("LastRun = %v, want %v", recurringRepo.items[0].LastRun, want)
+	}
+
+	// A second run within the same period creates nothing.
+	cardRepo.createdCard = nil
+	if err := uc.RunDue(context.Background(), now.Add(time.Hour)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cardRepo.createdCard != nil {
+		t.Error("expected no card on second run")
+	}
+}
+
+func TestRecurringUseCase_RunDue_AlreadyMaterialized(t *testing.T) {
+	cardRepo, recurringRepo, uc := newRecurringFixture()
+	// The card exists but LastRun was never recorded, e.g. after a crash.
+	cardRepo.cards = []domain.Card{{ID: "20260119-001", Title: "Weekly report", List: "todo", Recurrence: "weekly-report@2026-01-19T09:00:00Z"}}
+
+	if err := uc.RunDue(context.Background(), time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cardRepo.createdCard != nil {
+		t.Error("expected no duplicate card")
+	}
+	if recurringRepo.items[0].LastRun.IsZero() {
+		t.Error("expected LastRun to be recorded")
+	}
+}
+
+func TestRecurringUseCase_List(t *testing.T) {
+	_, _, uc := newRecurringFixture()
+
+	items, err := uc.List(context.Background(), "board-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("got %d items, want 1", len(items))
+	}
+	if want := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC); !items[0].NextRun.Equal(want) {
+		t.Errorf("NextRun = %v, want %v", items[0].NextRun, want)
+	}
+}
+
+func TestRecurringUseCase_Save_InvalidList(t *testing.T) {
+	_, _, uc := newRecurringFixture()
+
+	_, err := uc.Save(context.Background(), "board-1", &domain.RecurringCard{
+		ID: "x", Rule: "FREQ=DAILY", Start: time.Now(), Title: "X", List: "missing",
+	})
+	if err == nil {
+		t.Error("expected error, got nil")
+	}
+}
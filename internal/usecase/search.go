@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// DefaultSearchLimit caps the hits returned when no limit is given.
+const DefaultSearchLimit = 20
+
+// SearchUseCase answers full-text queries from an in-memory index. The index
+// is built once by Rebuild and then kept current by writes through
+// IndexingRepository and by Changed, which the file watcher calls.
+type SearchUseCase struct {
+	cardRepo  domain.CardRepository
+	boardRepo domain.BoardRepository
+	index     *searchIndex
+}
+
+func NewSearchUseCase(cardRepo domain.CardRepository, boardRepo domain.BoardRepository) *SearchUseCase {
+	return &SearchUseCase{cardRepo: cardRepo, boardRepo: boardRepo, index: newSearchIndex()}
+}
+
+// SearchQuery is a full-text query. Limit <= 0 means DefaultSearchLimit.
+type SearchQuery struct {
+	Text            string
+	IncludeArchived bool
+	Limit           int
+}
+
+func (uc *SearchUseCase) Search(_ context.Context, q SearchQuery) ([]domain.SearchHit, error) {
+	if strings.TrimSpace(q.Text) == "" {
+		return nil, &domain.ErrValidation{Field: "q", Message: "is required"}
+	}
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	return uc.index.search(q.Text, q.IncludeArchived, limit), nil
+}
+
+// Rebuild indexes every card on every board.
+func (uc *SearchUseCase) Rebuild(ctx context.Context) error {
+	boards, err := uc.boardRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, b := range boards {
+		if err := uc.reindexBoard(ctx, b.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Changed re-reads a card whose file changed outside the usecases. An empty
+// cardID re-reads the whole board; a deleted board is dropped from the index.
+func (uc *SearchUseCase) Changed(ctx context.Context, boardID, cardID string) error {
+	if cardID == "" {
+		return uc.reindexBoard(ctx, boardID)
+	}
+
+	card, err := uc.cardRepo.Get(ctx, boardID, cardID)
+	if err != nil {
+		var notFound *domain.ErrNotFound
+		if errors.As(err, &notFound) {
+			uc.index.remove(boardID, cardID)
+			return nil
+		}
+		return err
+	}
+	uc.index.put(boardID, card)
+	return nil
+}
+
+func (uc *SearchUseCase) reindexBoard(ctx context.Context, boardID string) error {
+	if _, err := uc.boardRepo.Get(ctx, boardID); err != nil {
+		var notFound *domain.ErrNotFound
+		if errors.As(err, &notFound) {
+			uc.index.replaceBoard(boardID, nil)
+			return nil
+		}
+		return err
+	}
+
+	cards, err := uc.cardRepo.ListByBoard(ctx, boardID, true)
+	if err != nil {
+		return err
+	}
+	uc.index.replaceBoard(boardID, cards)
+	return nil
+}
+
+// IndexingRepository wraps repo so that cards written through it are
+// reindexed immediately, without waiting for the file watcher.
+func (uc *SearchUseCase) IndexingRepository(repo domain.CardRepository) domain.CardRepository {
+	return &indexingCardRepo{CardRepository: repo, index: uc.index}
+}
+
+type indexingCardRepo struct {
+	domain.CardRepository
+	index *searchIndex
+}
+
+func (r *indexingCardRepo) Save(ctx context.Context, boardID string, card *domain.Card) error {
+	if err := r.CardRepository.Save(ctx, boardID, card); err != nil {
+		return err
+	}
+	r.index.put(boardID, card)
+	return nil
+}
+
+func (r *indexingCardRepo) SaveAll(ctx context.Context, writes []domain.CardWrite) error {
+	if err := r.CardRepository.SaveAll(ctx, writes); err != nil {
+		return err
+	}
+	for _, w := range writes {
+		if w.Delete {
+			r.index.remove(w.BoardID, w.Card.ID)
+		} else {
+			r.index.put(w.BoardID, w.Card)
+		}
+	}
+	return nil
+}
+
+func (r *indexingCardRepo) Delete(ctx context.Context, boardID, cardID string) error {
+	if err := r.CardRepository.Delete(ctx, boardID, cardID); err != nil {
+		return err
+	}
+	r.index.remove(boardID, cardID)
+	return nil
+}
+
+func (r *indexingCardRepo) Create(ctx context.Context, boardID string, card *domain.Card) (string, error) {
+	id, err := r.CardRepository.Create(ctx, boardID, card)
+	if err != nil {
+		return "", err
+	}
+	indexed := *card
+	indexed.ID = id
+	r.index.put(boardID, &indexed)
+	return id, nil
+}
+
+func (r *indexingCardRepo) Transfer(ctx context.Context, fromBoardID, toBoardID string, card *domain.Card) (string, error) {
+	oldID := card.ID
+	id, err := r.CardRepository.Transfer(ctx, fromBoardID, toBoardID, card)
+	if err != nil {
+		return "", err
+	}
+	r.index.remove(fromBoardID, oldID)
+	r.index.put(toBoardID, card)
+	return id, nil
+}
@@ -0,0 +1,144 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+func searchFixture(t *testing.T) (*usecase.SearchUseCase, *mockCardRepo) {
+	t.Helper()
+	board := domain.Board{ID: "board-1"}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "Fix login bug", List: "todo", Description: "Users cannot sign in with SSO."},
+		{ID: "card-2", Title: "Write docs", List: "todo", Labels: []string{"bug"}},
+		{ID: "card-3", Title: "Release", List: "done", Todos: []domain.TodoItem{{Text: "Tag the login fix"}}},
+		{ID: "card-4", Title: "Old login page", List: "done", Archived: true},
+		{ID: "card-5", Title: "請求書の作成", List: "todo"},
+	}}
+	uc := usecase.NewSearchUseCase(cardRepo, &mockBoardRepo{boards: []domain.Board{board}, board: &board})
+	if err := uc.Rebuild(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return uc, cardRepo
+}
+
+func hitIDs(hits []domain.SearchHit) []string {
+	ids := make([]string, len(hits))
+	for i, h := range hits {
+		ids[i] = h.CardID
+	}
+	return ids
+}
+
+func TestSearchUseCase_Search(t *testing.T) {
+	tests := []struct {
+		name  string
+		query usecase.SearchQuery
+		want  []string
+	}{
+		{name: "title ranks above todo", query: usecase.SearchQuery{Text: "login"}, want: []string{"card-1", "card-3"}},
+		{name: "include archived", query: usecase.SearchQuery{Text: "login", IncludeArchived: true}, want: []string{"card-1", "card-4", "card-3"}},
+		{name: "all terms required", query: usecase.SearchQuery{Text: "login sso"}, want: []string{"card-1"}},
+		{name: "prefix match", query: usecase.SearchQuery{Text: "rel"}, want: []string{"card-3"}},
+		{name: "title ranks above label", query: usecase.SearchQuery{Text: "BUG"}, want: []string{"card-1", "card-2"}},
+		{name: "cjk", query: usecase.SearchQuery{Text: "請求"}, want: []string{"card-5"}},
+		{name: "limit", query: usecase.SearchQuery{Text: "login", Limit: 1}, want: []string{"card-1"}},
+		{name: "no match", query: usecase.SearchQuery{Text: "nothing"}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _ := searchFixture(t)
+			hits, err := uc.Search(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := hitIDs(hits)
+			if len(got) != len(tt.want) {
+				t.Fatalf("hits = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("hits = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestSearchUseCase_Search_Snippet(t *testing.T) {
+	uc, _ := searchFixture(t)
+	hits, err := uc.Search(context.Background(), usecase.SearchQuery{Text: "sso"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(hits) != 1 || hits[0].Snippet != "Users cannot sign in with SSO." {
+		t.Errorf("unexpected hits: %+v", hits)
+	}
+}
+
+func TestSearchUseCase_Search_EmptyQuery(t *testing.T) {
+	uc, _ := searchFixture(t)
+	_, err := uc.Search(context.Background(), usecase.SearchQuery{Text: "  "})
+	var ve *domain.ErrValidation
+	if !errors.As(err, &ve) {
+		t.Errorf("expected ErrValidation, got %v", err)
+	}
+}
+
+func TestSearchUseCase_IndexingRepository(t *testing.T) {
+	uc, cardRepo := searchFixture(t)
+	repo := uc.IndexingRepository(cardRepo)
+	ctx := context.Background()
+
+	cardRepo.nextID = "card-6"
+	if _, err := repo.Create(ctx, "board-1", &domain.Card{Title: "Quarterly roadmap", List: "todo"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := repo.Save(ctx, "board-1", &domain.Card{ID: "card-1", Title: "Fix signup bug", List: "todo"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := repo.Delete(ctx, "board-1", "card-3"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hits, _ := uc.Search(ctx, usecase.SearchQuery{Text: "roadmap"})
+	if got := hitIDs(hits); len(got) != 1 || got[0] != "card-6" {
+		t.Errorf("roadmap hits = %v, want [card-6]", got)
+	}
+	hits, _ = uc.Search(ctx, usecase.SearchQuery{Text: "login"})
+	if got := hitIDs(hits); len(got) != 0 {
+		t.Errorf("login hits = %v, want none", got)
+	}
+}
+
+func TestSearchUseCase_Changed(t *testing.T) {
+	uc, cardRepo := searchFixture(t)
+	ctx := context.Background()
+
+	cardRepo.cards[1].Title = "Write changelog"
+	if err := uc.Changed(ctx, "board-1", "card-2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hits, _ := uc.Search(ctx, usecase.SearchQuery{Text: "changelog"})
+	if got := hitIDs(hits); len(got) != 1 || got[0] != "card-2" {
+		t.Errorf("changelog hits = %v, want [card-2]", got)
+	}
+
+	if err := uc.Changed(ctx, "board-1", "card-missing"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := uc.Changed(ctx, "board-gone", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	hits, _ = uc.Search(ctx, usecase.SearchQuery{Text: "changelog"})
+	if len(hits) != 1 {
+		t.Errorf("expected other boards to be untouched, got %v", hitIDs(hits))
+	}
+}
@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// Field weights used when ranking search hits.
+const (
+	weightTitle       = 3.0
+	weightLabel       = 2.0
+	weightDescription = 1.0
+	weightTodo        = 1.0
+)
+
+const snippetRadius = 40
+
+type docKey struct {
+	board string
+	card  string
+}
+
+type searchDoc struct {
+	hit  domain.SearchHit
+	text []string // description and todo texts, searched for snippets
+	// terms maps each indexed term to its weighted frequency in the card.
+	terms map[string]float64
+}
+
+// searchIndex is an in-memory inverted index over cards.
+type searchIndex struct {
+	mu       sync.RWMutex
+	docs     map[docKey]*searchDoc
+	postings map[string]map[docKey]float64
+}
+
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		docs:     make(map[docKey]*searchDoc),
+		postings: make(map[string]map[docKey]float64),
+	}
+}
+
+func (idx *searchIndex) put(boardID string, card *domain.Card) {
+	doc := &searchDoc{
+		hit: domain.SearchHit{
+			BoardID:  boardID,
+			CardID:   card.ID,
+			Title:    card.Title,
+			List:     card.List,
+			Labels:   card.Labels,
+			Archived: card.Archived,
+		},
+		terms: make(map[string]float64),
+	}
+	addTerms := func(text string, weight float64) {
+		for _, t := range tokenize(text) {
+			doc.terms[t] += weight
+		}
+	}
+	addTerms(card.Title, weightTitle)
+	for _, l := range card.Labels {
+		addTerms(l, weightLabel)
+	}
+	addTerms(card.Description, weightDescription)
+	if card.Description != "" {
+		doc.text = append(doc.text, card.Description)
+	}
+	for _, t := range card.Todos {
+		addTerms(t.Text, weightTodo)
+		doc.text = append(doc.text, t.Text)
+	}
+
+	key := docKey{board: boardID, card: card.ID}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.removeLocked(key)
+	idx.docs[key] = doc
+	for t, w := range doc.terms {
+		p := idx.postings[t]
+		if p == nil {
+			p = make(map[docKey]float64)
+			idx.postings[t] = p
+		}
+		p[key] = w
+	}
+}
+
+func (idx *searchIndex) remove(boardID, cardID string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.removeLocked(docKey{board: boardID, card: cardID})
+}
+
+// replaceBoard drops every card of the board and indexes cards instead.
+func (idx *searchIndex) replaceBoard(boardID string, cards []domain.Card) {
+	idx.mu.Lock()
+	for key := range idx.docs {
+		if key.board == boardID {
+			idx.removeLocked(key)
+		}
+	}
+	idx.mu.Unlock()
+
+	for i := range cards {
+		idx.put(boardID, &cards[i])
+	}
+}
+
+func (idx *searchIndex) removeLocked(key docKey) {
+	doc, ok := idx.docs[key]
+	if !ok {
+		return
+	}
+	for t := range doc.terms {
+		delete(idx.postings[t], key)
+		if len(idx.postings[t]) == 0 {
+			delete(idx.postings, t)
+		}
+	}
+	delete(idx.docs, key)
+}
+
+// search returns cards containing every query term, where a term matches any
+// indexed term it is a prefix of. Scores are TF-IDF with field weights.
+func (idx *searchIndex) search(query string, includeArchived bool, limit int) []domain.SearchHit {
+	terms := tokenize(query)
+	if len(terms) == 0 {
+		return []domain.SearchHit{}
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	n := float64(len(idx.docs))
+	var scores map[docKey]float64
+	for _, qt := range terms {
+		termScores := make(map[docKey]float64)
+		for t, p := range idx.postings {
+			if !strings.HasPrefix(t, qt) {
+				continue
+			}
+			idf := math.Log(1 + n/float64(len(p)))
+			for key, w := range p {
+				termScores[key] += w * idf
+			}
+		}
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		for key := range scores {
+			if s, ok := termScores[key]; ok {
+				scores[key] += s
+			} else {
+				delete(scores, key)
+			}
+		}
+	}
+
+	hits := []domain.SearchHit{}
+	for key, score := range scores {
+		doc := idx.docs[key]
+		if doc.hit.Archived && !includeArchived {
+			continue
+		}
+		hit := doc.hit
+		hit.Score = math.Round(score*1000) / 1000
+		hit.Snippet = snippet(doc.text, terms)
+		hits = append(hits, hit)
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		if hits[i].BoardID != hits[j].BoardID {
+			return hits[i].BoardID < hits[j].BoardID
+		}
+		return hits[i].CardID < hits[j].CardID
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+// tokenize lowercases text and splits it into words. Runs of CJK characters,
+// which are not separated by spaces, are split into overlapping bigrams.
+func tokenize(text string) []string {
+	var tokens []string
+	var word, cjk []rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		switch {
+		case len(cjk) == 1:
+			tokens = append(tokens, string(cjk))
+		case len(cjk) > 1:
+			for i := 0; i+1 < len(cjk); i++ {
+				tokens = append(tokens, string(cjk[i:i+2]))
+			}
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, unicode.ToLower(r))
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) || r == 'ー'
+}
+
+// snippet returns an excerpt of the first text containing a query term. When
+// nothing matches, the start of the first text is used.
+func snippet(texts []string, terms []string) string {
+	for _, text := range texts {
+		runes := []rune(text)
+		lower := make([]rune, len(runes))
+		for i, r := range runes {
+			lower[i] = unicode.ToLower(r)
+		}
+		lowerText := string(lower)
+
+		for _, t := range terms {
+			byteIdx := strings.Index(lowerText, t)
+			if byteIdx < 0 {
+				continue
+			}
+			pos := len([]rune(lowerText[:byteIdx]))
+			return excerpt(runes, pos-snippetRadius, pos+len([]rune(t))+snippetRadius)
+		}
+	}
+	if len(texts) > 0 {
+		runes := []rune(texts[0])
+		return excerpt(runes, 0, 2*snippetRadius)
+	}
+	return ""
+}
+
+func excerpt(runes []rune, from, to int) string {
+	from = max(from, 0)
+	to = min(to, len(runes))
+	s := strings.Join(strings.Fields(string(runes[from:to])), " ")
+	if from > 0 {
+		s = "…" + s
+	}
+	if to < len(runes) {
+		s += "…"
+	}
+	return s
+}
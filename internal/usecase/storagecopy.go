@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// Storage is one place boards and cards can be kept. Trash and Counters may
+// be nil for a storage without a trash or ID counters; they are then neither
+// read nor written.
+type Storage struct {
+	Boards   domain.BoardRepository
+	Cards    domain.CardRepository
+	Trash    domain.TrashCopier
+	Counters domain.IDCounterRepository
+}
+
+// StorageCopyResult counts what StorageCopyUseCase.Copy copied.
+type StorageCopyResult struct {
+	Boards     int `json:"boards"`
+	Cards      int `json:"cards"`
+	TrashItems int `json:"trash_items"`
+}
+
+// StorageCopyUseCase copies boards and cards from one storage to another, as
+// when switching between the YAML files and SQLite.
+type StorageCopyUseCase struct {
+	from Storage
+	to   Storage
+}
+
+func NewStorageCopyUseCase(from, to Storage) *StorageCopyUseCase {
+	return &StorageCopyUseCase{from: from, to: to}
+}
+
+// Copy copies every board with all its cards, archived ones included,
+// keeping IDs, ranks and timestamps, together with the boards' ID counters
+// and the trash. The target must not hold any boards yet, so that nothing in
+// it is silently overwritten.
+func (uc *StorageCopyUseCase) Copy(ctx context.Context) (*StorageCopyResult, error) {
+	existing, err := uc.to.Boards.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, &domain.ErrConflict{Resource: "board", ID: existing[0].ID}
+	}
+
+	boards, err := uc.from.Boards.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &StorageCopyResult{}
+	for i := range boards {
+		board := &boards[i]
+		cards, err := uc.from.Cards.ListByBoard(ctx, board.ID, true)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.to.Boards.Save(ctx, board); err != nil {
+			return nil, fmt.Errorf("copy board %s: %w", board.ID, err)
+		}
+		writes := make([]domain.CardWrite, len(cards))
+		for j := range cards {
+			writes[j] = domain.CardWrite{BoardID: board.ID, Card: &cards[j]}
+		}
+		if err := uc.to.Cards.SaveAll(ctx, writes); err != nil {
+			return nil, fmt.Errorf("copy cards of board %s: %w", board.ID, err)
+		}
+		if err := uc.copyIDCounter(ctx, board.ID); err != nil {
+			return nil, fmt.Errorf("copy id counter of board %s: %w", board.ID, err)
+		}
+		result.Boards++
+		result.Cards += len(cards)
+	}
+
+	n, err := uc.copyTrash(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("copy trash: %w", err)
+	}
+	result.TrashItems = n
+	return result, nil
+}
+
+// copyTrash copies the trashed items the target's trash does not hold yet,
+// as it does after an earlier copy the other way, and returns their number.
+func (uc *StorageCopyUseCase) copyTrash(ctx context.Context) (int, error) {
+	if uc.from.Trash == nil || uc.to.Trash == nil {
+		return 0, nil
+	}
+	entries, err := uc.from.Trash.ExportTrash(ctx)
+	if err != nil {
+		return 0, err
+	}
+	existing, err := uc.to.Trash.ExportTrash(ctx)
+	if err != nil {
+		return 0, err
+	}
+	held := make(map[string]bool, len(existing))
+	for _, e := range existing {
+		held[e.Item.ID] = true
+	}
+	var missing []domain.TrashEntry
+	for _, e := range entries {
+		if !held[e.Item.ID] {
+			missing = append(missing, e)
+		}
+	}
+	if err := uc.to.Trash.ImportTrash(ctx, missing); err != nil {
+		return 0, err
+	}
+	return len(missing), nil
+}
+
+func (uc *StorageCopyUseCase) copyIDCounter(ctx context.Context, boardID string) error {
+	if uc.from.Counters == nil || uc.to.Counters == nil {
+		return nil
+	}
+	counter, err := uc.from.Counters.IDCounter(ctx, boardID)
+	if err != nil || counter == 0 {
+		return err
+	}
+	return uc.to.Counters.SetIDCounter(ctx, boardID, counter)
+}
@@ -0,0 +1,173 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/memory"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/sqlite"
+	yamlstore "github.com/hiroto-aibara/secretary-ai/internal/infra/yaml"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+func TestStorageCopyUseCase_Copy(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2026, 1, 24, 10, 0, 0, 0, time.UTC)
+	src := memory.NewStore()
+	for _, b := range []domain.Board{{ID: "board-1", Name: "A"}, {ID: "board-2", Name: "B"}} {
+		if err := src.Save(ctx, &b); err != nil {
+			t.Fatalf("Save board: %v", err)
+		}
+	}
+	err := src.SaveCards(ctx, []domain.CardWrite{
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-1", Title: "A", List: "todo", Rank: "i", CreatedAt: created}},
+		{BoardID: "board-1", Card: &domain.Card{ID: "card-2", Title: "B", List: "todo", Rank: "j", Archived: true}},
+		{BoardID: "board-2", Card: &domain.Card{ID: "card-1", Title: "C", List: "todo", Rank: "i"}},
+	})
+	if err != nil {
+		t.Fatalf("Save cards: %v", err)
+	}
+	if err := src.SetIDCounter(ctx, "board-2", 7); err != nil {
+		t.Fatalf("SetIDCounter: %v", err)
+	}
+	dst := memory.NewStore()
+	from := usecase.Storage{Boards: src, Cards: memory.NewCardRepositoryAdapter(src), Counters: src}
+	to := usecase.Storage{Boards: dst, Cards: memory.NewCardRepositoryAdapter(dst), Counters: dst}
+
+	result, err := usecase.NewStorageCopyUseCase(from, to).Copy(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Boards != 2 || result.Cards != 3 {
+		t.Errorf("result = %+v, want 2 boards and 3 cards", result)
+	}
+	if boards, _ := dst.List(ctx); len(boards) != 2 {
+		t.Errorf("copied %d boards, want 2", len(boards))
+	}
+	got, err := dst.GetCard(ctx, "board-1", "card-1")
+	if err != nil || !got.CreatedAt.Equal(created) || got.Rank != "i" {
+		t.Errorf("copied card = %v, %v", got, err)
+	}
+	if archived, err := dst.GetCard(ctx, "board-1", "card-2"); err != nil || !archived.Archived {
+		t.Errorf("archived card = %v, %v", archived, err)
+	}
+	if counter, _ := dst.IDCounter(ctx, "board-2"); counter != 7 {
+		t.Errorf("IDCounter = %d, want 7", counter)
+	}
+}
+
+func TestStorageCopyUseCase_Copy_TrashAndCounters(t *testing.T) {
+	ctx := context.Background()
+	lists := []domain.List{{ID: "todo", Name: "Todo"}}
+	yamlSrc := yamlstore.NewStore(t.TempDir())
+	for _, b := range []domain.Board{
+		{ID: "board-1", Name: "A", Lists: lists, IDFormat: domain.CardIDPrefix, IDPrefix: "PROJ"},
+		{ID: "board-2", Name: "B", Lists: lists},
+	} {
+		if err := yamlSrc.Save(ctx, &b); err != nil {
+			t.Fatalf("Save board: %v", err)
+		}
+	}
+	cards := yamlstore.NewCardRepositoryAdapter(yamlSrc)
+	for _, title := range []string{"kept", "deleted"} {
+		if _, err := cards.Create(ctx, "board-1", &domain.Card{Title: title, List: "todo", Rank: "i"}); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+	if err := cards.Save(ctx, "board-2", &domain.Card{ID: "card-1", Title: "on board 2", List: "todo", Rank: "i"}); err != nil {
+		t.Fatalf("Save card: %v", err)
+	}
+	if err := cards.Delete(ctx, "board-1", "PROJ-2"); err != nil {
+		t.Fatalf("Delete card: %v", err)
+	}
+	if err := yamlSrc.Delete(ctx, "board-2"); err != nil {
+		t.Fatalf("Delete board: %v", err)
+	}
+
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "tasks.db"), nil)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer db.Close()
+	yamlStorage := func(s *yamlstore.Store) usecase.Storage {
+		return usecase.Storage{Boards: s, Cards: yamlstore.NewCardRepositoryAdapter(s), Trash: s, Counters: s}
+	}
+	sqliteStorage := usecase.Storage{Boards: db, Cards: sqlite.NewCardRepositoryAdapter(db), Trash: db, Counters: db}
+
+	result, err := usecase.NewStorageCopyUseCase(yamlStorage(yamlSrc), sqliteStorage).Copy(ctx)
+	if err != nil {
+		t.Fatalf("Copy to sqlite: %v", err)
+	}
+	if result.Boards != 1 || result.Cards != 1 || result.TrashItems != 2 {
+		t.Errorf("result = %+v, want 1 board, 1 card and 2 trash items", result)
+	}
+
+	// The counter keeps the deleted card's number from being issued again.
+	id, err := sqlite.NewCardRepositoryAdapter(db).NextID(ctx, "board-1")
+	if err != nil || id != "PROJ-3" {
+		t.Errorf("NextID = %q, %v, want PROJ-3", id, err)
+	}
+
+	// Both trashed items can be restored from the copy.
+	trash := sqlite.NewTrashRepositoryAdapter(db)
+	items, err := trash.List(ctx)
+	if err != nil || len(items) != 2 {
+		t.Fatalf("trash = %+v, %v", items, err)
+	}
+	yamlBack := yamlstore.NewStore(t.TempDir())
+	for _, item := range items {
+		if _, err := trash.Restore(ctx, item.ID); err != nil {
+			t.Fatalf("Restore %s: %v", item.Kind, err)
+		}
+	}
+	if card, err := db.GetCard(ctx, "board-1", "PROJ-2"); err != nil || card.Title != "deleted" {
+		t.Errorf("restored card = %v, %v", card, err)
+	}
+	if card, err := db.GetCard(ctx, "board-2", "card-1"); err != nil || card.Title != "on board 2" {
+		t.Errorf("card of restored board = %v, %v", card, err)
+	}
+
+	// And back: a trashed card copied from SQLite restores in the YAML store.
+	if err := sqlite.NewCardRepositoryAdapter(db).Delete(ctx, "board-1", "PROJ-2"); err != nil {
+		t.Fatalf("Delete card: %v", err)
+	}
+	result, err = usecase.NewStorageCopyUseCase(sqliteStorage, yamlStorage(yamlBack)).Copy(ctx)
+	if err != nil {
+		t.Fatalf("Copy to yaml: %v", err)
+	}
+	if result.Boards != 2 || result.TrashItems != 1 {
+		t.Errorf("result = %+v, want 2 boards and 1 trash item", result)
+	}
+	if counter, _ := yamlBack.IDCounter(ctx, "board-1"); counter != 3 {
+		t.Errorf("IDCounter = %d, want 3", counter)
+	}
+	backTrash := yamlstore.NewTrashRepositoryAdapter(yamlBack)
+	items, err = backTrash.List(ctx)
+	if err != nil || len(items) != 1 {
+		t.Fatalf("trash = %+v, %v", items, err)
+	}
+	if _, err := backTrash.Restore(ctx, items[0].ID); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if card, err := yamlBack.GetCard(ctx, "board-1", "PROJ-2"); err != nil || card.Title != "deleted" {
+		t.Errorf("restored card = %v, %v", card, err)
+	}
+}
+
+func TestStorageCopyUseCase_Copy_TargetNotEmpty(t *testing.T) {
+	from := usecase.Storage{Boards: &mockBoardRepo{}, Cards: memory.NewCardRepositoryAdapter(memory.NewStore())}
+	to := usecase.Storage{
+		Boards: &mockBoardRepo{boards: []domain.Board{{ID: "board-1", Name: "A"}}},
+		Cards:  memory.NewCardRepositoryAdapter(memory.NewStore()),
+	}
+
+	_, err := usecase.NewStorageCopyUseCase(from, to).Copy(context.Background())
+	var conflict *domain.ErrConflict
+	if !errors.As(err, &conflict) {
+		t.Errorf("expected ErrConflict, got %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+type TemplateUseCase struct {
+	templateRepo domain.TemplateRepository
+	boardRepo    domain.BoardRepository
+	cardUC       *CardUseCase
+}
+
+func NewTemplateUseCase(templateRepo domain.TemplateRepository, boardRepo domain.BoardRepository, cardUC *CardUseCase) *TemplateUseCase {
+	return &TemplateUseCase{templateRepo: templateRepo, boardRepo: boardRepo, cardUC: cardUC}
+}
+
+func (uc *TemplateUseCase) List(ctx context.Context, boardID string) ([]domain.CardTemplate, error) {
+	if _, err := uc.boardRepo.Get(ctx, boardID); err != nil {
+		return nil, err
+	}
+	return uc.templateRepo.ListByBoard(ctx, boardID)
+}
+
+func (uc *TemplateUseCase) Get(ctx context.Context, boardID, templateID string) (*domain.CardTemplate, error) {
+	if err := domain.ValidateTemplateID(templateID); err != nil {
+		return nil, err
+	}
+	if _, err := uc.boardRepo.Get(ctx, boardID); err != nil {
+		return nil, err
+	}
+	return uc.templateRepo.Get(ctx, boardID, templateID)
+}
+
+// CreateCard merges the template into card and creates it. The merged card
+// goes through the same validation as CardUseCase.Create; an unknown template
+// is reported as a validation error rather than a missing resource.
+func (uc *TemplateUseCase) CreateCard(ctx context.Context, boardID, templateID string, card *domain.Card) (*domain.Card, error) {
+	if err := domain.ValidateTemplateID(templateID); err != nil {
+		return nil, err
+	}
+	if _, err := uc.boardRepo.Get(ctx, boardID); err != nil {
+		return nil, err
+	}
+
+	t, err := uc.templateRepo.Get(ctx, boardID, templateID)
+	if err != nil {
+		var notFound *domain.ErrNotFound
+		if errors.As(err, &notFound) {
+			return nil, &domain.ErrValidation{Field: "template", Message: "template '" + templateID + "' does not exist in board"}
+		}
+		return nil, err
+	}
+	t.ApplyTo(card)
+	return uc.cardUC.Create(ctx, boardID, card)
+}
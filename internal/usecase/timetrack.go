@@ -0,0 +1,334 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// TimeLog is a manually entered time entry.
+type TimeLog struct {
+	User    string
+	Minutes int
+	// Start is when the work began; zero means it ended now.
+	Start time.Time
+	Note  string
+}
+
+// StartTimer starts a timer for user on the card. A timer the user has
+// running on any other card is stopped first, so each user has at most one.
+// Starts for one user run one at a time, and hold the locks of only the card's
+// board and the boards where the user's timers may be running.
+func (uc *CardUseCase) StartTimer(ctx context.Context, boardID, cardID, user, note string) (*domain.Card, error) {
+	id, err := newTimeEntryID()
+	if err != nil {
+		return nil, err
+	}
+	entry := domain.TimeEntry{ID: id, User: user, Start: time.Now(), Note: note}
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+
+	timers := uc.timers.user(user)
+	timers.start.Lock()
+	defer timers.start.Unlock()
+	running, err := uc.runningTimers(ctx, timers, user)
+	if err != nil {
+		return nil, err
+	}
+	// A card moved to another board meanwhile changes the boards to lock.
+	for {
+		boardIDs := []string{boardID}
+		for _, ref := range running {
+			boardIDs = append(boardIDs, ref.Board)
+		}
+		unlock := uc.locks.lock(boardIDs...)
+		again := uc.timers.cards(timers)
+		if !slices.ContainsFunc(again, func(ref domain.CardRef) bool { return !slices.Contains(boardIDs, ref.Board) }) {
+			running = again
+			defer unlock()
+			break
+		}
+		unlock()
+		running = again
+	}
+
+	card, err := uc.cardRepo.Get(ctx, boardID, cardID)
+	if err != nil {
+		return nil, err
+	}
+	if card.RunningEntry(user) >= 0 {
+		return nil, &domain.ErrConflict{Resource: "running timer for user", ID: user}
+	}
+
+	entry.Start = time.Now()
+	if err := uc.stopRunningTimersLocked(ctx, running, user, entry.Start); err != nil {
+		return nil, err
+	}
+
+	started, err := uc.modifyCardLocked(ctx, boardID, cardID, func(c *domain.Card) error {
+		c.TimeEntries = append(c.TimeEntries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.timers.setCards(timers, []domain.CardRef{{Board: boardID, ID: cardID}})
+	return started, nil
+}
+
+func (uc *CardUseCase) StopTimer(ctx context.Context, boardID, cardID, user string) (*domain.Card, error) {
+	return uc.modifyCard(ctx, boardID, cardID, func(c *domain.Card) error {
+		idx := c.RunningEntry(user)
+		if idx < 0 {
+			return &domain.ErrNotFound{Resource: "running timer for user", ID: user}
+		}
+		c.TimeEntries[idx].End = time.Now()
+		return nil
+	})
+}
+
+func (uc *CardUseCase) LogTime(ctx context.Context, boardID, cardID string, log TimeLog) (*domain.Card, error) {
+	if log.Minutes <= 0 {
+		return nil, &domain.ErrValidation{Field: "minutes", Message: "must be positive"}
+	}
+
+	id, err := newTimeEntryID()
+	if err != nil {
+		return nil, err
+	}
+	d := time.Duration(log.Minutes) * time.Minute
+	entry := domain.TimeEntry{ID: id, User: log.User, Start: log.Start, End: log.Start.Add(d), Note: log.Note}
+	if log.Start.IsZero() {
+		entry.End = time.Now()
+		entry.Start = entry.End.Add(-d)
+	}
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+
+	return uc.modifyCard(ctx, boardID, cardID, func(c *domain.Card) error {
+		c.TimeEntries = append(c.TimeEntries, entry)
+		return nil
+	})
+}
+
+func (uc *CardUseCase) DeleteTimeEntry(ctx context.Context, boardID, cardID, entryID string) (*domain.Card, error) {
+	return uc.modifyCard(ctx, boardID, cardID, func(c *domain.Card) error {
+		for i, e := range c.TimeEntries {
+			if e.ID == entryID {
+				c.TimeEntries = append(c.TimeEntries[:i], c.TimeEntries[i+1:]...)
+				return nil
+			}
+		}
+		return &domain.ErrNotFound{Resource: "time entry", ID: entryID}
+	})
+}
+
+// TimeReport sums logged time on the board's cards, archived ones included.
+// Running timers count up to now. Time on a card with several labels counts
+// toward each of them, and entries are grouped by the ISO week they started in.
+func (uc *CardUseCase) TimeReport(ctx context.Context, boardID string, now time.Time) (*domain.TimeReport, error) {
+	if _, err := uc.boardRepo.Get(ctx, boardID); err != nil {
+		return nil, err
+	}
+	cards, err := uc.cardRepo.ListByBoard(ctx, boardID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.TimeReport{
+		BoardID: boardID,
+		ByCard:  []domain.CardTime{},
+		ByLabel: []domain.LabelTime{},
+		ByWeek:  []domain.WeekTime{},
+	}
+	byLabel := make(map[string]time.Duration)
+	byWeek := make(map[string]time.Duration)
+	var total time.Duration
+
+	for i := range cards {
+		c := &cards[i]
+		if c.EstimateMinutes == 0 && len(c.TimeEntries) == 0 {
+			continue
+		}
+
+		var logged time.Duration
+		for j := range c.TimeEntries {
+			d := c.TimeEntries[j].Duration(now)
+			logged += d
+			byWeek[domain.ISOWeek(c.TimeEntries[j].Start)] += d
+		}
+		for _, l := range c.Labels {
+			byLabel[l] += logged
+		}
+		total += logged
+
+		report.EstimateMinutes += c.EstimateMinutes
+		report.ByCard = append(report.ByCard, domain.CardTime{
+			CardID:          c.ID,
+			Title:           c.Title,
+			EstimateMinutes: c.EstimateMinutes,
+			LoggedMinutes:   minutes(logged),
+		})
+	}
+
+	for l, d := range byLabel {
+		report.ByLabel = append(report.ByLabel, domain.LabelTime{Label: l, LoggedMinutes: minutes(d)})
+	}
+	for w, d := range byWeek {
+		report.ByWeek = append(report.ByWeek, domain.WeekTime{Week: w, LoggedMinutes: minutes(d)})
+	}
+	sort.Slice(report.ByLabel, func(i, j int) bool { return report.ByLabel[i].Label < report.ByLabel[j].Label })
+	sort.Slice(report.ByWeek, func(i, j int) bool { return report.ByWeek[i].Week < report.ByWeek[j].Week })
+	report.LoggedMinutes = minutes(total)
+	return report, nil
+}
+
+// runningTimers returns the cards that may hold the user's running timers.
+// The first call for a user searches every board; a card moved while it
+// searches makes it search again.
+func (uc *CardUseCase) runningTimers(ctx context.Context, timers *userTimers, user string) ([]domain.CardRef, error) {
+	for {
+		if running, ok := uc.timers.loadedCards(timers); ok {
+			return running, nil
+		}
+		moves := uc.timers.moveCount()
+		boards, err := uc.boardRepo.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var running []domain.CardRef
+		for _, b := range boards {
+			cards, err := uc.cardRepo.ListByBoard(ctx, b.ID, true)
+			if err != nil {
+				return nil, err
+			}
+			for i := range cards {
+				if cards[i].RunningEntry(user) >= 0 {
+					running = append(running, domain.CardRef{Board: b.ID, ID: cards[i].ID})
+				}
+			}
+		}
+		uc.timers.load(timers, running, moves)
+	}
+}
+
+// stopRunningTimersLocked ends the user's running timers on the cards, whose
+// boards the caller holds the locks of. A card no longer there, or with no
+// timer running, is skipped.
+func (uc *CardUseCase) stopRunningTimersLocked(ctx context.Context, running []domain.CardRef, user string, now time.Time) error {
+	for _, ref := range running {
+		card, err := uc.cardRepo.Get(ctx, ref.Board, ref.ID)
+		if err != nil {
+			var notFound *domain.ErrNotFound
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return err
+		}
+		idx := card.RunningEntry(user)
+		if idx < 0 {
+			continue
+		}
+		card.TimeEntries[idx].End = now
+		card.UpdatedAt = now
+		if err := uc.cardRepo.Save(ctx, ref.Board, card); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// timerIndex keeps, per user, the cards that may hold the user's running
+// timer, so that starting a timer need not lock every board. A user's cards
+// are found by searching all boards when a timer is first started for them,
+// then kept by StartTimer and by MoveToBoard, which is the only way a card
+// with a running timer changes board or ID.
+type timerIndex struct {
+	mu    sync.Mutex
+	users map[string]*userTimers
+	// moves counts cards moved between boards, so that a search that
+	// overlapped a move can tell.
+	moves int
+}
+
+type userTimers struct {
+	// start serializes StartTimer for the user.
+	start  sync.Mutex
+	loaded bool
+	refs   []domain.CardRef
+}
+
+func (x *timerIndex) user(user string) *userTimers {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.users == nil {
+		x.users = make(map[string]*userTimers)
+	}
+	t, ok := x.users[user]
+	if !ok {
+		t = &userTimers{}
+		x.users[user] = t
+	}
+	return t
+}
+
+func (x *timerIndex) cards(t *userTimers) []domain.CardRef {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return slices.Clone(t.refs)
+}
+
+// loadedCards is cards, once a search has filled them in.
+func (x *timerIndex) loadedCards(t *userTimers) ([]domain.CardRef, bool) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return slices.Clone(t.refs), t.loaded
+}
+
+func (x *timerIndex) moveCount() int {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return x.moves
+}
+
+// load stores the cards a search found, unless a card was moved since the
+// search began at move count moves.
+func (x *timerIndex) load(t *userTimers, refs []domain.CardRef, moves int) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.moves == moves {
+		t.refs, t.loaded = refs, true
+	}
+}
+
+func (x *timerIndex) setCards(t *userTimers, refs []domain.CardRef) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	t.refs, t.loaded = refs, true
+}
+
+// moved follows a card moved from one board to another.
+func (x *timerIndex) moved(from, to domain.CardRef) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.moves++
+	for _, t := range x.users {
+		for i, ref := range t.refs {
+			if ref == from {
+				t.refs[i] = to
+			}
+		}
+	}
+}
+
+func minutes(d time.Duration) int {
+	return int(d.Round(time.Minute) / time.Minute)
+}
@@ -0,0 +1,211 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+func TestCardUseCase_StartTimer(t *testing.T) {
+	board := domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}
+	started := time.Now().Add(-time.Hour)
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "A", List: "todo", TimeEntries: []domain.TimeEntry{{ID: "e1", User: "alice", Start: started}}},
+		{ID: "card-2", Title: "B", List: "todo"},
+	}}
+	boardRepo := &mockBoardRepo{boards: []domain.Board{board}, board: &board}
+	uc := usecase.NewCardUseCase(cardRepo, boardRepo)
+
+	got, err := uc.StartTimer(context.Background(), "board-1", "card-2", "alice", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.RunningEntry("alice") < 0 {
+		t.Error("expected a running timer on card-2")
+	}
+	if cardRepo.cards[0].RunningEntry("alice") >= 0 {
+		t.Error("expected the timer on card-1 to be stopped")
+	}
+
+	_, err = uc.StartTimer(context.Background(), "board-1", "card-2", "alice", "")
+	var conflict *domain.ErrConflict
+	if !errors.As(err, &conflict) {
+		t.Errorf("expected ErrConflict, got %v", err)
+	}
+}
+
+func TestCardUseCase_StartTimer_Concurrent(t *testing.T) {
+	board := domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}
+	var cards []domain.Card
+	for i := range 8 {
+		cards = append(cards, domain.Card{ID: fmt.Sprintf("card-%d", i), Title: "T", List: "todo"})
+	}
+	_, stored := newMemoryStore(t, nil, map[string][]domain.Card{"board-1": cards})
+	cardRepo := &slowCardRepo{stored}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{boards: []domain.Board{board}, board: &board})
+	ctx := context.Background()
+
+	// The user starts a timer on every card at once; only the last start
+	// may leave its timer running.
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Go(func() {
+			if _, err := uc.StartTimer(ctx, "board-1", fmt.Sprintf("card-%d", i), "alice", ""); err != nil {
+				t.Errorf("StartTimer: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	cards, _ = cardRepo.ListByBoard(ctx, "board-1", true)
+	running := 0
+	for i := range cards {
+		if cards[i].RunningEntry("alice") >= 0 {
+			running++
+		}
+	}
+	if running != 1 {
+		t.Errorf("got %d running timers, want 1", running)
+	}
+}
+
+func TestCardUseCase_StopTimer_NotRunning(t *testing.T) {
+	cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1", Title: "A", List: "todo"}}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+	_, err := uc.StopTimer(context.Background(), "board-1", "card-1", "alice")
+	var notFound *domain.ErrNotFound
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCardUseCase_LogTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		log     usecase.TimeLog
+		wantErr bool
+	}{
+		{name: "success", log: usecase.TimeLog{User: "alice", Minutes: 90}},
+		{name: "with start", log: usecase.TimeLog{User: "alice", Minutes: 30, Start: time.Date(2026, 1, 19, 9, 0, 0, 0, time.UTC)}},
+		{name: "zero minutes", log: usecase.TimeLog{User: "alice"}, wantErr: true},
+		{name: "missing user", log: usecase.TimeLog{Minutes: 10}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardRepo := &mockCardRepo{card: &domain.Card{ID: "card-1", Title: "A", List: "todo"}}
+			uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+			got, err := uc.LogTime(context.Background(), "board-1", "card-1", tt.log)
+			if tt.wantErr {
+				var ve *domain.ErrValidation
+				if !errors.As(err, &ve) {
+					t.Errorf("expected ErrValidation, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got.TimeEntries) != 1 {
+				t.Fatalf("got %d entries, want 1", len(got.TimeEntries))
+			}
+			if d := got.TimeEntries[0].Duration(time.Now()); d != time.Duration(tt.log.Minutes)*time.Minute {
+				t.Errorf("duration = %v, want %d minutes", d, tt.log.Minutes)
+			}
+		})
+	}
+}
+
+func TestCardUseCase_TimeReport(t *testing.T) {
+	monday := time.Date(2026, 1, 19, 9, 0, 0, 0, time.UTC) // 2026-W04
+	board := domain.Board{ID: "board-1"}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "A", Labels: []string{"bug", "ui"}, EstimateMinutes: 120, TimeEntries: []domain.TimeEntry{
+			{ID: "e1", User: "alice", Start: monday, End: monday.Add(90 * time.Minute)},
+			{ID: "e2", User: "bob", Start: monday.AddDate(0, 0, 7), End: monday.AddDate(0, 0, 7).Add(30 * time.Minute)},
+		}},
+		{ID: "card-2", Title: "B", Labels: []string{"bug"}, TimeEntries: []domain.TimeEntry{
+			{ID: "e3", User: "alice", Start: monday, End: monday.Add(15 * time.Minute)},
+		}},
+		{ID: "card-3", Title: "Untracked"},
+	}}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{board: &board})
+
+	report, err := uc.TimeReport(context.Background(), "board-1", monday.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.LoggedMinutes != 135 || report.EstimateMinutes != 120 {
+		t.Errorf("totals = %d/%d, want 135/120", report.LoggedMinutes, report.EstimateMinutes)
+	}
+	if len(report.ByCard) != 2 || report.ByCard[0].LoggedMinutes != 120 {
+		t.Errorf("ByCard = %+v", report.ByCard)
+	}
+	wantLabels := []domain.LabelTime{{Label: "bug", LoggedMinutes: 135}, {Label: "ui", LoggedMinutes: 120}}
+	if len(report.ByLabel) != 2 || report.ByLabel[0] != wantLabels[0] || report.ByLabel[1] != wantLabels[1] {
+		t.Errorf("ByLabel = %+v, want %+v", report.ByLabel, wantLabels)
+	}
+	wantWeeks := []domain.WeekTime{{Week: "2026-W04", LoggedMinutes: 105}, {Week: "2026-W05", LoggedMinutes: 30}}
+	if len(report.ByWeek) != 2 || report.ByWeek[0] != wantWeeks[0] || report.ByWeek[1] != wantWeeks[1] {
+		t.Errorf("ByWeek = %+v, want %+v", report.ByWeek, wantWeeks)
+	}
+}
+
+// listCountingCardRepo counts the boards whose cards are listed.
+type listCountingCardRepo struct {
+	domain.CardRepository
+	mu     sync.Mutex
+	listed int
+}
+
+func (r *listCountingCardRepo) ListByBoard(ctx context.Context, boardID string, includeArchived bool) ([]domain.Card, error) {
+	r.mu.Lock()
+	r.listed++
+	r.mu.Unlock()
+	return r.CardRepository.ListByBoard(ctx, boardID, includeArchived)
+}
+
+func TestCardUseCase_StartTimer_AfterMoveToBoard(t *testing.T) {
+	lists := []domain.List{{ID: "todo", Name: "Todo"}}
+	store, stored := newMemoryStore(t, []domain.Board{
+		{ID: "board-1", Lists: lists}, {ID: "board-2", Lists: lists}, {ID: "board-3", Lists: lists},
+	}, map[string][]domain.Card{
+		"board-1": {{ID: "card-1", Title: "Moved", List: "todo", Rank: "a"}},
+		"board-2": {{ID: "card-1", Title: "Taken", List: "todo", Rank: "a"}},
+		"board-3": {{ID: "card-3", Title: "Next", List: "todo", Rank: "a"}},
+	})
+	cardRepo := &listCountingCardRepo{CardRepository: stored}
+	uc := usecase.NewCardUseCase(cardRepo, store)
+	ctx := context.Background()
+
+	if _, err := uc.StartTimer(ctx, "board-1", "card-1", "alice", ""); err != nil {
+		t.Fatalf("StartTimer: %v", err)
+	}
+	moved, err := uc.MoveToBoard(ctx, "board-1", "card-1", "board-2", "todo", 0)
+	if err != nil {
+		t.Fatalf("MoveToBoard: %v", err)
+	}
+
+	// Once the user's timers are known, a start does not search the boards.
+	cardRepo.listed = 0
+	if _, err := uc.StartTimer(ctx, "board-3", "card-3", "alice", ""); err != nil {
+		t.Fatalf("StartTimer: %v", err)
+	}
+	if cardRepo.listed != 0 {
+		t.Errorf("listed %d boards, want none", cardRepo.listed)
+	}
+
+	card, _ := cardRepo.Get(ctx, "board-2", moved.ID)
+	if card.RunningEntry("alice") >= 0 {
+		t.Error("expected the timer on the moved card to be stopped")
+	}
+}
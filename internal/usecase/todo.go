@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// TodoUpdate holds the fields of a todo item to change. Nil fields are left as is.
+type TodoUpdate struct {
+	Text      *string
+	Completed *bool
+}
+
+func (uc *CardUseCase) AddTodo(ctx context.Context, boardID, cardID, text string) (*domain.Card, error) {
+	todo := domain.TodoItem{Text: strings.TrimSpace(text)}
+	if err := todo.Validate(); err != nil {
+		return nil, err
+	}
+
+	id, err := newTodoID()
+	if err != nil {
+		return nil, err
+	}
+	todo.ID = id
+
+	return uc.modifyCard(ctx, boardID, cardID, func(card *domain.Card) error {
+		card.Todos = append(card.Todos, todo)
+		return nil
+	})
+}
+
+func (uc *CardUseCase) UpdateTodo(ctx context.Context, boardID, cardID, todoID string, update TodoUpdate) (*domain.Card, error) {
+	return uc.modifyCard(ctx, boardID, cardID, func(card *domain.Card) error {
+		idx, err := findTodo(card, todoID)
+		if err != nil {
+			return err
+		}
+		todo := card.Todos[idx]
+		if update.Text != nil {
+			todo.Text = strings.TrimSpace(*update.Text)
+			if err := todo.Validate(); err != nil {
+				return err
+			}
+		}
+		if update.Completed != nil {
+			todo.Completed = *update.Completed
+		}
+		card.Todos[idx] = todo
+		return nil
+	})
+}
+
+func (uc *CardUseCase) ToggleTodo(ctx context.Context, boardID, cardID, todoID string) (*domain.Card, error) {
+	return uc.modifyCard(ctx, boardID, cardID, func(card *domain.Card) error {
+		idx, err := findTodo(card, todoID)
+		if err != nil {
+			return err
+		}
+		card.Todos[idx].Completed = !card.Todos[idx].Completed
+		return nil
+	})
+}
+
+// MoveTodo moves a todo item to position within the checklist. Out of range
+// positions are clamped.
+func (uc *CardUseCase) MoveTodo(ctx context.Context, boardID, cardID, todoID string, position int) (*domain.Card, error) {
+	return uc.modifyCard(ctx, boardID, cardID, func(card *domain.Card) error {
+		idx, err := findTodo(card, todoID)
+		if err != nil {
+			return err
+		}
+		todo := card.Todos[idx]
+		rest := append(card.Todos[:idx:idx], card.Todos[idx+1:]...)
+		position = max(0, min(position, len(rest)))
+
+		todos := make([]domain.TodoItem, 0, len(card.Todos))
+		todos = append(todos, rest[:position]...)
+		todos = append(todos, todo)
+		todos = append(todos, rest[position:]...)
+		card.Todos = todos
+		return nil
+	})
+}
+
+func (uc *CardUseCase) DeleteTodo(ctx context.Context, boardID, cardID, todoID string) (*domain.Card, error) {
+	return uc.modifyCard(ctx, boardID, cardID, func(card *domain.Card) error {
+		idx, err := findTodo(card, todoID)
+		if err != nil {
+			return err
+		}
+		card.Todos = append(card.Todos[:idx:idx], card.Todos[idx+1:]...)
+		return nil
+	})
+}
+
+// ConvertTodo turns a todo item into a subtask card of cardID and removes it
+// from the checklist. An empty list places the new card in the parent's list.
+func (uc *CardUseCase) ConvertTodo(ctx context.Context, boardID, cardID, todoID, list string) (*domain.Card, error) {
+	defer uc.locks.lock(boardID)()
+
+	card, err := uc.cardRepo.Get(ctx, boardID, cardID)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := findTodo(card, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if list == "" {
+		list = card.List
+	}
+
+	todo := card.Todos[idx]
+	created, err := uc.createLocked(ctx, boardID, &domain.Card{
+		Title:  todo.Text,
+		List:   list,
+		Parent: cardID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Placing the subtask may have rebalanced the parent's rank, so the
+	// parent is read again rather than saved from the copy above.
+	if _, err := uc.modifyCardLocked(ctx, boardID, cardID, func(card *domain.Card) error {
+		idx, err := findTodo(card, todoID)
+		if err != nil {
+			return err
+		}
+		card.Todos = append(card.Todos[:idx:idx], card.Todos[idx+1:]...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// modifyCard loads a card, applies fn and saves the result, holding the
+// board's lock so that concurrent changes to the card are not lost.
+func (uc *CardUseCase) modifyCard(ctx context.Context, boardID, cardID string, fn func(*domain.Card) error) (*domain.Card, error) {
+	defer uc.locks.lock(boardID)()
+	return uc.modifyCardLocked(ctx, boardID, cardID, fn)
+}
+
+// modifyCardLocked is modifyCard for a caller that holds the board's lock.
+func (uc *CardUseCase) modifyCardLocked(ctx context.Context, boardID, cardID string, fn func(*domain.Card) error) (*domain.Card, error) {
+	card, err := uc.cardRepo.Get(ctx, boardID, cardID)
+	if err != nil {
+		return nil, err
+	}
+	if err := fn(card); err != nil {
+		return nil, err
+	}
+	card.UpdatedAt = time.Now()
+
+	if err := uc.cardRepo.Save(ctx, boardID, card); err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+func findTodo(card *domain.Card, todoID string) (int, error) {
+	idx := card.FindTodo(todoID)
+	if idx < 0 {
+		return -1, &domain.ErrNotFound{Resource: "todo", ID: todoID}
+	}
+	return idx, nil
+}
+
+// normalizeTodos validates a checklist submitted as a whole, trimming text and
+// assigning IDs to new items. Duplicate IDs are rejected.
+func normalizeTodos(todos []domain.TodoItem) error {
+	seen := make(map[string]bool, len(todos))
+	for i := range todos {
+		todos[i].Text = strings.TrimSpace(todos[i].Text)
+		if err := todos[i].Validate(); err != nil {
+			return err
+		}
+		if todos[i].ID == "" {
+			id, err := newTodoID()
+			if err != nil {
+				return err
+			}
+			todos[i].ID = id
+		}
+		if seen[todos[i].ID] {
+			return &domain.ErrValidation{Field: "todos.id", Message: "duplicate id '" + todos[i].ID + "'"}
+		}
+		seen[todos[i].ID] = true
+	}
+	return nil
+}
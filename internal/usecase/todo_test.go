@@ -0,0 +1,280 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+func todoCard() *domain.Card {
+	return &domain.Card{ID: "card-1", Title: "Test", List: "todo", Todos: []domain.TodoItem{
+		{ID: "t1", Text: "one"},
+		{ID: "t2", Text: "two"},
+		{ID: "t3", Text: "three"},
+	}}
+}
+
+func todoIDs(card *domain.Card) string {
+	ids := make([]string, len(card.Todos))
+	for i, t := range card.Todos {
+		ids[i] = t.ID
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestCardUseCase_AddTodo(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr bool
+	}{
+		{name: "success", text: "  write tests  "},
+		{name: "empty text", text: "   ", wantErr: true},
+		{name: "too long", text: strings.Repeat("a", domain.MaxTodoTextLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardRepo := &mockCardRepo{card: todoCard()}
+			uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+			got, err := uc.AddTodo(context.Background(), "board-1", "card-1", tt.text)
+			if tt.wantErr {
+				var ve *domain.ErrValidation
+				if !errors.As(err, &ve) {
+					t.Errorf("expected ErrValidation, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			added := got.Todos[len(got.Todos)-1]
+			if added.ID == "" || added.Text != "write tests" {
+				t.Errorf("added todo = %+v", added)
+			}
+		})
+	}
+}
+
+func TestCardUseCase_UpdateTodo(t *testing.T) {
+	cardRepo := &mockCardRepo{card: todoCard()}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+	text := "edited"
+	done := true
+	got, err := uc.UpdateTodo(context.Background(), "board-1", "card-1", "t2", usecase.TodoUpdate{Text: &text, Completed: &done})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Todos[1].Text != "edited" || !got.Todos[1].Completed {
+		t.Errorf("todo = %+v, want edited and completed", got.Todos[1])
+	}
+
+	_, err = uc.UpdateTodo(context.Background(), "board-1", "card-1", "missing", usecase.TodoUpdate{Completed: &done})
+	var nf *domain.ErrNotFound
+	if !errors.As(err, &nf) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCardUseCase_ToggleTodo(t *testing.T) {
+	cardRepo := &mockCardRepo{card: todoCard()}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+	got, err := uc.ToggleTodo(context.Background(), "board-1", "card-1", "t1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.Todos[0].Completed {
+		t.Error("expected todo to be completed")
+	}
+}
+
+func TestCardUseCase_MoveTodo(t *testing.T) {
+	tests := []struct {
+		name     string
+		todoID   string
+		position int
+		want     string
+	}{
+		{"to front", "t3", 0, "t3,t1,t2"},
+		{"to back", "t1", 2, "t2,t3,t1"},
+		{"clamped", "t1", 99, "t2,t3,t1"},
+		{"negative", "t2", -1, "t2,t1,t3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardRepo := &mockCardRepo{card: todoCard()}
+			uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+			got, err := uc.MoveTodo(context.Background(), "board-1", "card-1", tt.todoID, tt.position)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ids := todoIDs(got); ids != tt.want {
+				t.Errorf("order = %s, want %s", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestCardUseCase_DeleteTodo(t *testing.T) {
+	cardRepo := &mockCardRepo{card: todoCard()}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+	got, err := uc.DeleteTodo(context.Background(), "board-1", "card-1", "t2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ids := todoIDs(got); ids != "t1,t3" {
+		t.Errorf("order = %s, want t1,t3", ids)
+	}
+}
+
+func TestCardUseCase_ConvertTodo(t *testing.T) {
+	cardRepo := &mockCardRepo{card: todoCard(), nextID: "20260124-002"}
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}}
+	uc := usecase.NewCardUseCase(cardRepo, boardRepo)
+
+	got, err := uc.ConvertTodo(context.Background(), "board-1", "card-1", "t2", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Title != "two" || got.List != "todo" || got.Parent != "card-1" {
+		t.Errorf("created card = %+v", got)
+	}
+	if ids := todoIDs(cardRepo.savedCard); ids != "t1,t3" {
+		t.Errorf("remaining todos = %s, want t1,t3", ids)
+	}
+}
+
+func TestCardUseCase_ConvertTodo_KeepsRebalancedParent(t *testing.T) {
+	// The parent's rank leaves no room after it, so placing the subtask at
+	// the end of the list rebalances the parent too.
+	parent := todoCard()
+	parent.Rank = strings.Repeat("z", domain.MaxRankLength)
+	_, cardRepo := newMemoryStore(t, nil, map[string][]domain.Card{"board-1": {*parent}})
+	boardRepo := &mockBoardRepo{board: &domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}}}
+	uc := usecase.NewCardUseCase(cardRepo, boardRepo)
+
+	created, err := uc.ConvertTodo(context.Background(), "board-1", "card-1", "t2", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, _ := cardRepo.Get(context.Background(), "board-1", "card-1")
+	if got.Rank == parent.Rank || got.Rank >= created.Rank {
+		t.Errorf("parent rank = %q, subtask rank = %q, want the parent rebalanced before the subtask", got.Rank, created.Rank)
+	}
+	if ids := todoIDs(got); ids != "t1,t3" {
+		t.Errorf("remaining todos = %s, want t1,t3", ids)
+	}
+}
+
+func TestCardUseCase_AddTodo_Concurrent(t *testing.T) {
+	_, stored := newMemoryStore(t, nil, map[string][]domain.Card{"board-1": {*todoCard()}})
+	cardRepo := &slowCardRepo{stored}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+	ctx := context.Background()
+
+	// Without the board lock, adds that read the card at the same time
+	// would each save it with only their own item.
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() {
+			if _, err := uc.AddTodo(ctx, "board-1", "card-1", fmt.Sprintf("item %d", i)); err != nil {
+				t.Errorf("AddTodo: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	card, _ := cardRepo.Get(ctx, "board-1", "card-1")
+	if len(card.Todos) != 13 {
+		t.Errorf("got %d todos, want 13", len(card.Todos))
+	}
+}
+
+func TestCardUseCase_Update_ConcurrentWithToggleTodo(t *testing.T) {
+	_, stored := newMemoryStore(t, nil, map[string][]domain.Card{"board-1": {*todoCard()}})
+	cardRepo := &slowCardRepo{stored}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+	ctx := context.Background()
+
+	// Without the board lock, the update and the toggle would read the card
+	// at the same time and the later save would drop the other's change.
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		if _, err := uc.Update(ctx, "board-1", "card-1", &domain.Card{Title: "Renamed"}); err != nil {
+			t.Errorf("Update: %v", err)
+		}
+	})
+	for _, id := range []string{"t1", "t2", "t3"} {
+		wg.Go(func() {
+			if _, err := uc.ToggleTodo(ctx, "board-1", "card-1", id); err != nil {
+				t.Errorf("ToggleTodo: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	card, _ := cardRepo.Get(ctx, "board-1", "card-1")
+	if card.Title != "Renamed" || card.CompletedTodos() != 3 {
+		t.Errorf("card = %q with %d of 3 todos done, want both changes kept", card.Title, card.CompletedTodos())
+	}
+}
+
+func TestCardUseCase_Update_InvalidTodos(t *testing.T) {
+	tests := []struct {
+		name  string
+		todos []domain.TodoItem
+		field string
+	}{
+		{
+			name:  "duplicate id",
+			todos: []domain.TodoItem{{ID: "t1", Text: "a"}, {ID: "t1", Text: "b"}},
+			field: "todos.id",
+		},
+		{
+			name:  "empty text",
+			todos: []domain.TodoItem{{ID: "t1", Text: ""}},
+			field: "todos.text",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardRepo := &mockCardRepo{card: todoCard()}
+			uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+			_, err := uc.Update(context.Background(), "board-1", "card-1", &domain.Card{Todos: tt.todos})
+			var ve *domain.ErrValidation
+			if !errors.As(err, &ve) {
+				t.Fatalf("expected ErrValidation, got %v", err)
+			}
+			if ve.Field != tt.field {
+				t.Errorf("field = %s, want %s", ve.Field, tt.field)
+			}
+		})
+	}
+}
+
+func TestCardUseCase_Update_AssignsTodoIDs(t *testing.T) {
+	cardRepo := &mockCardRepo{card: todoCard()}
+	uc := usecase.NewCardUseCase(cardRepo, &mockBoardRepo{})
+
+	got, err := uc.Update(context.Background(), "board-1", "card-1", &domain.Card{Todos: []domain.TodoItem{{Text: "new"}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Todos[0].ID == "" {
+		t.Error("expected server-generated todo ID")
+	}
+}
@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// MoveToBoard moves a card to toList on another board, keeping its ID when it
+// is free there and always keeping its timestamps, comments and attachments.
+// Custom field values the target board does not accept are dropped, the parent
+// link is cleared and subtasks left behind are detached. Blocked-by links on
+// other cards are rewritten to the card's new location. The boards holding
+// those cards stay locked throughout, and the subtasks and linked cards are
+// saved together.
+func (uc *CardUseCase) MoveToBoard(ctx context.Context, boardID, cardID, toBoardID, toList string, order int) (*domain.Card, error) {
+	if toBoardID == boardID {
+		return uc.Move(ctx, boardID, cardID, toList, order)
+	}
+	from := domain.CardRef{Board: boardID, ID: cardID}
+	unlock, linked, err := uc.lockLinkedBoards(ctx, from, toBoardID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	target, err := uc.boardRepo.Get(ctx, toBoardID)
+	if err != nil {
+		return nil, err
+	}
+	if !target.HasList(toList) {
+		return nil, &domain.ErrValidation{
+			Field:   "list",
+			Message: "list '" + toList + "' does not exist in board",
+		}
+	}
+
+	card, err := uc.cardRepo.Get(ctx, boardID, cardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.IsDoneList(toList) {
+		if err := uc.checkUnblocked(ctx, target, card); err != nil {
+			return nil, err
+		}
+	}
+
+	card.Fields = acceptedFields(target, card.Fields)
+	if err := target.ValidateFieldValues(card.Fields); err != nil {
+		return nil, err
+	}
+
+	card.List = toList
+	card.Parent = ""
+	rebalanced, err := uc.placeCard(ctx, toBoardID, card, order, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.cardRepo.SaveAll(ctx, rebalanced); err != nil {
+		return nil, err
+	}
+	card.UpdatedAt = time.Now()
+
+	newID, err := uc.cardRepo.Transfer(ctx, boardID, toBoardID, card)
+	if err != nil {
+		return nil, err
+	}
+	card.ID = newID
+
+	to := domain.CardRef{Board: toBoardID, ID: newID}
+	uc.timers.moved(from, to)
+	if err := uc.unlinkMovedCard(ctx, linked, from, to); err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+// acceptedFields returns the values that board defines and accepts.
+func acceptedFields(board *domain.Board, values map[string]any) map[string]any {
+	var kept map[string]any
+	for id, v := range values {
+		def, ok := board.Field(id)
+		if !ok || def.ValidateValue(v) != nil {
+			continue
+		}
+		if kept == nil {
+			kept = make(map[string]any, len(values))
+		}
+		kept[id] = v
+	}
+	return kept
+}
+
+// lockLinkedBoards locks from's board, the other given boards and every board
+// with a card blocked by from, and returns the unlock function and the boards
+// with such cards. Adding a link to from takes the lock on from's board, so
+// once it is held the set cannot grow; a link added while the boards were
+// being found makes it start over.
+func (uc *CardUseCase) lockLinkedBoards(ctx context.Context, from domain.CardRef, boardIDs ...string) (func(), []string, error) {
+	for {
+		linked, err := uc.boardsBlockedBy(ctx, from)
+		if err != nil {
+			return nil, nil, err
+		}
+		unlock := uc.locks.lock(append([]string{from.Board}, append(boardIDs, linked...)...)...)
+		again, err := uc.boardsBlockedBy(ctx, from)
+		if err != nil {
+			unlock()
+			return nil, nil, err
+		}
+		if !slices.ContainsFunc(again, func(id string) bool { return !slices.Contains(linked, id) }) {
+			return unlock, again, nil
+		}
+		unlock()
+	}
+}
+
+// boardsBlockedBy returns the boards with a card blocked by ref.
+func (uc *CardUseCase) boardsBlockedBy(ctx context.Context, ref domain.CardRef) ([]string, error) {
+	boards, err := uc.boardRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, b := range boards {
+		cards, err := uc.cardRepo.ListByBoard(ctx, b.ID, true)
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(cards, func(c domain.Card) bool { return c.IsBlockedBy(ref) }) {
+			ids = append(ids, b.ID)
+		}
+	}
+	return ids, nil
+}
+
+// unlinkMovedCard detaches the subtasks from left behind and replaces
+// blocked-by links to from with links to to on the linked boards, saving
+// every changed card at once.
+func (uc *CardUseCase) unlinkMovedCard(ctx context.Context, linked []string, from, to domain.CardRef) error {
+	now := time.Now()
+	var writes []domain.CardWrite
+	for _, boardID := range slices.Compact(slices.Sorted(slices.Values(append(linked, from.Board)))) {
+		cards, err := uc.cardRepo.ListByBoard(ctx, boardID, true)
+		if err != nil {
+			return err
+		}
+		for i := range cards {
+			c := &cards[i]
+			changed := false
+			if boardID == from.Board && c.Parent == from.ID {
+				c.Parent = ""
+				changed = true
+			}
+			for j, ref := range c.BlockedBy {
+				if ref == from {
+					c.BlockedBy[j] = to
+					changed = true
+				}
+			}
+			if changed {
+				c.UpdatedAt = now
+				writes = append(writes, domain.CardWrite{BoardID: boardID, Card: c})
+			}
+		}
+	}
+	return uc.cardRepo.SaveAll(ctx, writes)
+}
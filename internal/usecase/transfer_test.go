@@ -0,0 +1,159 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/infra/memory"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+// newMemoryStore returns a memory store holding boards and, per board ID,
+// cards, with the card repository over it.
+func newMemoryStore(t *testing.T, boards []domain.Board, cards map[string][]domain.Card) (*memory.Store, domain.CardRepository) {
+	t.Helper()
+	ctx := context.Background()
+	store := memory.NewStore()
+	for i := range boards {
+		if err := store.Save(ctx, &boards[i]); err != nil {
+			t.Fatalf("Save board: %v", err)
+		}
+	}
+	cardRepo := memory.NewCardRepositoryAdapter(store)
+	for boardID, cs := range cards {
+		for i := range cs {
+			if err := cardRepo.Save(ctx, boardID, &cs[i]); err != nil {
+				t.Fatalf("Save card: %v", err)
+			}
+		}
+	}
+	return store, cardRepo
+}
+
+func transferFixture(t *testing.T) (*usecase.CardUseCase, domain.CardRepository) {
+	store, cardRepo := newMemoryStore(t, []domain.Board{
+		{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}}},
+		{ID: "board-2", Lists: []domain.List{{ID: "backlog", Name: "Backlog"}, {ID: "done", Name: "Done", Done: true}},
+			Fields: []domain.FieldDef{{ID: "points", Name: "Points", Type: domain.FieldNumber}}},
+	}, map[string][]domain.Card{
+		"board-1": {
+			{ID: "card-1", Title: "Epic", List: "todo", Rank: "a"},
+			{ID: "card-2", Title: "Moved", List: "todo", Rank: "b", Parent: "card-1",
+				Fields: map[string]any{"points": 3, "severity": "high"}},
+			{ID: "card-3", Title: "Child", List: "todo", Rank: "c", Parent: "card-2"},
+			{ID: "card-4", Title: "Waiting", List: "todo", Rank: "d", BlockedBy: []domain.CardRef{{Board: "board-1", ID: "card-2"}}},
+		},
+		"board-2": {
+			{ID: "card-2", Title: "Existing", List: "backlog", Rank: "i"},
+		},
+	})
+	return usecase.NewCardUseCase(cardRepo, store), cardRepo
+}
+
+func TestCardUseCase_MoveToBoard(t *testing.T) {
+	uc, cardRepo := transferFixture(t)
+	ctx := context.Background()
+
+	moved, err := uc.MoveToBoard(ctx, "board-1", "card-2", "board-2", "backlog", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if moved.ID == "card-2" || moved.List != "backlog" || moved.Parent != "" {
+		t.Errorf("moved = %+v", moved)
+	}
+	if len(moved.Fields) != 1 || moved.Fields["points"] != 3 {
+		t.Errorf("Fields = %v, want only points", moved.Fields)
+	}
+
+	if moved.Order != 0 || moved.Rank >= "i" {
+		t.Errorf("moved card rank = %q, order = %d; want it first in the list", moved.Rank, moved.Order)
+	}
+
+	// Only the moved card is ranked; the cards left behind keep their ranks.
+	source, _ := cardRepo.ListByBoard(ctx, "board-1", true)
+	for _, c := range source {
+		if want := map[string]string{"card-1": "a", "card-3": "c", "card-4": "d"}[c.ID]; c.Rank != want {
+			t.Errorf("source card %s rank = %q, want %q", c.ID, c.Rank, want)
+		}
+		if c.ID == "card-3" && c.Parent != "" {
+			t.Error("expected subtask to be detached")
+		}
+		if c.ID == "card-4" && (len(c.BlockedBy) != 1 || c.BlockedBy[0] != (domain.CardRef{Board: "board-2", ID: moved.ID})) {
+			t.Errorf("BlockedBy = %v, want link to the new location", c.BlockedBy)
+		}
+	}
+}
+
+func TestCardUseCase_MoveToBoard_ConcurrentWithUpdate(t *testing.T) {
+	lists := []domain.List{{ID: "todo", Name: "Todo"}}
+	store, stored := newMemoryStore(t, []domain.Board{
+		{ID: "board-1", Lists: lists}, {ID: "board-2", Lists: lists}, {ID: "board-3", Lists: lists},
+	}, map[string][]domain.Card{
+		"board-1": {{ID: "card-1", Title: "Moved", List: "todo", Rank: "a"}},
+		"board-3": {{ID: "card-9", Title: "Waiting", List: "todo", Rank: "a",
+			BlockedBy: []domain.CardRef{{Board: "board-1", ID: "card-1"}}}},
+	})
+	cardRepo := &slowCardRepo{stored}
+	uc := usecase.NewCardUseCase(cardRepo, store)
+	ctx := context.Background()
+
+	// Without board-3 locked, an update of the waiting card and the rewrite
+	// of its link would read it at the same time and one change would be lost.
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		if _, err := uc.MoveToBoard(ctx, "board-1", "card-1", "board-2", "todo", 0); err != nil {
+			t.Errorf("MoveToBoard: %v", err)
+		}
+	})
+	wg.Go(func() {
+		for i := range 20 {
+			if _, err := uc.Update(ctx, "board-3", "card-9", &domain.Card{Title: fmt.Sprintf("Renamed %d", i)}); err != nil {
+				t.Errorf("Update: %v", err)
+			}
+		}
+	})
+	wg.Wait()
+
+	card, _ := cardRepo.Get(ctx, "board-3", "card-9")
+	want := domain.CardRef{Board: "board-2", ID: "card-1"}
+	if card.Title != "Renamed 19" || len(card.BlockedBy) != 1 || card.BlockedBy[0] != want {
+		t.Errorf("card = %q blocked by %v, want the last rename and a link to %v", card.Title, card.BlockedBy, want)
+	}
+}
+
+func TestCardUseCase_MoveToBoard_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		cardID   string
+		toBoard  string
+		toList   string
+		notFound bool
+	}{
+		{name: "board not found", cardID: "card-2", toBoard: "board-9", toList: "todo", notFound: true},
+		{name: "list not on target", cardID: "card-2", toBoard: "board-2", toList: "todo"},
+		{name: "card not found", cardID: "card-9", toBoard: "board-2", toList: "backlog", notFound: true},
+		{name: "blocked into done list", cardID: "card-4", toBoard: "board-2", toList: "done"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _ := transferFixture(t)
+			_, err := uc.MoveToBoard(context.Background(), "board-1", tt.cardID, tt.toBoard, tt.toList, 0)
+			if tt.notFound {
+				var notFound *domain.ErrNotFound
+				if !errors.As(err, &notFound) {
+					t.Errorf("expected ErrNotFound, got %v", err)
+				}
+				return
+			}
+			var ve *domain.ErrValidation
+			if !errors.As(err, &ve) {
+				t.Errorf("expected ErrValidation, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+// TrashUseCase manages deleted boards and cards. Items are kept for the
+// retention period and then purged by RunDue.
+type TrashUseCase struct {
+	repo      domain.TrashRepository
+	retention time.Duration
+}
+
+func NewTrashUseCase(repo domain.TrashRepository, retention time.Duration) *TrashUseCase {
+	return &TrashUseCase{repo: repo, retention: retention}
+}
+
+func (uc *TrashUseCase) List(ctx context.Context) ([]domain.TrashItem, error) {
+	items, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].ExpiresAt = items[i].DeletedAt.Add(uc.retention)
+	}
+	return items, nil
+}
+
+// Restore puts a trashed board or card back. The returned item has the
+// card's final ID, which differs from the original if that ID was reused.
+func (uc *TrashUseCase) Restore(ctx context.Context, itemID string) (*domain.TrashItem, error) {
+	if err := domain.ValidateTrashItemID(itemID); err != nil {
+		return nil, err
+	}
+	return uc.repo.Restore(ctx, itemID)
+}
+
+func (uc *TrashUseCase) Purge(ctx context.Context, itemID string) error {
+	if err := domain.ValidateTrashItemID(itemID); err != nil {
+		return err
+	}
+	return uc.repo.Purge(ctx, itemID)
+}
+
+// RunDue purges every item whose retention period has passed at now.
+func (uc *TrashUseCase) RunDue(ctx context.Context, now time.Time) error {
+	items, err := uc.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, item := range items {
+		if now.Before(item.DeletedAt.Add(uc.retention)) {
+			continue
+		}
+		if err := uc.repo.Purge(ctx, item.ID); err != nil {
+			errs = append(errs, fmt.Errorf("trash item %s: %w", item.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,66 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type mockTrashRepo struct {
+	items  []domain.TrashItem
+	purged []string
+}
+
+func (m *mockTrashRepo) List(_ context.Context) ([]domain.TrashItem, error) {
+	return append([]domain.TrashItem(nil), m.items...), nil
+}
+
+func (m *mockTrashRepo) Restore(_ context.Context, itemID string) (*domain.TrashItem, error) {
+	for i := range m.items {
+		if m.items[i].ID == itemID {
+			item := m.items[i]
+			m.items = append(m.items[:i], m.items[i+1:]...)
+			return &item, nil
+		}
+	}
+	return nil, &domain.ErrNotFound{Resource: "trash item", ID: itemID}
+}
+
+func (m *mockTrashRepo) Purge(_ context.Context, itemID string) error {
+	m.purged = append(m.purged, itemID)
+	return nil
+}
+
+func TestTrashUseCase_List(t *testing.T) {
+	deleted := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := &mockTrashRepo{items: []domain.TrashItem{{ID: "a", Kind: domain.TrashCard, DeletedAt: deleted}}}
+	uc := usecase.NewTrashUseCase(repo, 7*24*time.Hour)
+
+	items, err := uc.List(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 1 || !items[0].ExpiresAt.Equal(deleted.AddDate(0, 0, 7)) {
+		t.Errorf("items = %+v", items)
+	}
+}
+
+func TestTrashUseCase_RunDue(t *testing.T) {
+	now := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	repo := &mockTrashRepo{items: []domain.TrashItem{
+		{ID: "expired", DeletedAt: now.AddDate(0, 0, -31)},
+		{ID: "exactly", DeletedAt: now.AddDate(0, 0, -30)},
+		{ID: "fresh", DeletedAt: now.AddDate(0, 0, -29)},
+	}}
+	uc := usecase.NewTrashUseCase(repo, 30*24*time.Hour)
+
+	if err := uc.RunDue(context.Background(), now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.purged) != 2 || repo.purged[0] != "expired" || repo.purged[1] != "exactly" {
+		t.Errorf("purged = %v, want [expired exactly]", repo.purged)
+	}
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+)
+
+type ViewUseCase struct {
+	viewRepo  domain.ViewRepository
+	boardRepo domain.BoardRepository
+	cardUC    *CardUseCase
+}
+
+func NewViewUseCase(viewRepo domain.ViewRepository, boardRepo domain.BoardRepository, cardUC *CardUseCase) *ViewUseCase {
+	return &ViewUseCase{viewRepo: viewRepo, boardRepo: boardRepo, cardUC: cardUC}
+}
+
+func (uc *ViewUseCase) List(ctx context.Context, boardID string) ([]domain.View, error) {
+	if _, err := uc.boardRepo.Get(ctx, boardID); err != nil {
+		return nil, err
+	}
+	return uc.viewRepo.ListByBoard(ctx, boardID)
+}
+
+func (uc *ViewUseCase) Get(ctx context.Context, boardID, viewID string) (*domain.View, error) {
+	views, err := uc.List(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range views {
+		if views[i].ID == viewID {
+			return &views[i], nil
+		}
+	}
+	return nil, &domain.ErrNotFound{Resource: "view", ID: viewID}
+}
+
+// Save creates or replaces a view. Its lists, fields and sort are checked
+// against the board so that a stored view can always be run.
+func (uc *ViewUseCase) Save(ctx context.Context, boardID string, view *domain.View) (*domain.View, error) {
+	board, err := uc.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := view.Validate(); err != nil {
+		return nil, err
+	}
+	q := viewQuery(view)
+	if err := q.Filter.validate(board); err != nil {
+		return nil, err
+	}
+	if q.Sort != "" {
+		if err := validateSort(q.Sort); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.viewRepo.Save(ctx, boardID, view); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func (uc *ViewUseCase) Delete(ctx context.Context, boardID, viewID string) error {
+	if _, err := uc.boardRepo.Get(ctx, boardID); err != nil {
+		return err
+	}
+	return uc.viewRepo.Delete(ctx, boardID, viewID)
+}
+
+// Cards runs a saved view. cursor and limit page through the result as in
+// CardUseCase.ListPage.
+func (uc *ViewUseCase) Cards(ctx context.Context, boardID, viewID, cursor string, limit int) (*CardPage, error) {
+	view, err := uc.Get(ctx, boardID, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	q := viewQuery(view)
+	q.Cursor = cursor
+	q.Limit = limit
+	return uc.cardUC.ListPage(ctx, boardID, q)
+}
+
+func viewQuery(v *domain.View) CardQuery {
+	f := v.Filter
+	return CardQuery{
+		Filter: CardFilter{
+			IncludeArchived: f.Archived,
+			Fields:          f.Fields,
+			Lists:           v.Lists,
+			Labels:          f.Labels,
+			LabelMatch:      LabelMatch(f.LabelMatch),
+			Text:            f.Text,
+			CreatedAfter:    f.CreatedAfter,
+			CreatedBefore:   f.CreatedBefore,
+			UpdatedAfter:    f.UpdatedAfter,
+			UpdatedBefore:   f.UpdatedBefore,
+		},
+		Sort: CardSort(v.Sort),
+		Desc: v.Direction == "desc",
+	}
+}
@@ -0,0 +1,138 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hiroto-aibara/secretary-ai/internal/domain"
+	"github.com/hiroto-aibara/secretary-ai/internal/usecase"
+)
+
+type mockViewRepo struct {
+	views []domain.View
+}
+
+func (m *mockViewRepo) ListByBoard(_ context.Context, _ string) ([]domain.View, error) {
+	return m.views, nil
+}
+
+func (m *mockViewRepo) Save(_ context.Context, _ string, view *domain.View) error {
+	for i := range m.views {
+		if m.views[i].ID == view.ID {
+			m.views[i] = *view
+			return nil
+		}
+	}
+	m.views = append(m.views, *view)
+	return nil
+}
+
+func (m *mockViewRepo) Delete(_ context.Context, _, viewID string) error {
+	for i := range m.views {
+		if m.views[i].ID == viewID {
+			m.views = append(m.views[:i], m.views[i+1:]...)
+			return nil
+		}
+	}
+	return &domain.ErrNotFound{Resource: "view", ID: viewID}
+}
+
+func newViewUseCase(viewRepo *mockViewRepo) *usecase.ViewUseCase {
+	board := domain.Board{ID: "board-1", Lists: []domain.List{{ID: "todo", Name: "Todo"}, {ID: "done", Name: "Done"}}}
+	boardRepo := &mockBoardRepo{board: &board}
+	cardRepo := &mockCardRepo{cards: []domain.Card{
+		{ID: "card-1", Title: "Crash on save", List: "todo", Labels: []string{"bug"}},
+		{ID: "card-2", Title: "Add export", List: "todo", Labels: []string{"feature"}},
+		{ID: "card-3", Title: "Broken link", List: "done", Labels: []string{"bug"}},
+		{ID: "card-4", Title: "Another bug", List: "todo", Labels: []string{"bug"}},
+	}}
+	return usecase.NewViewUseCase(viewRepo, boardRepo, usecase.NewCardUseCase(cardRepo, boardRepo))
+}
+
+func TestViewUseCase_Save(t *testing.T) {
+	tests := []struct {
+		name  string
+		view  domain.View
+		field string
+	}{
+		{name: "success", view: domain.View{ID: "bugs", Name: "Bugs", Filter: domain.ViewFilter{Labels: []string{"bug"}}, Sort: "title"}},
+		{name: "missing name", view: domain.View{ID: "bugs"}, field: "name"},
+		{name: "unknown list", view: domain.View{ID: "bugs", Name: "Bugs", Lists: []string{"doing"}}, field: "list"},
+		{name: "unknown field", view: domain.View{ID: "bugs", Name: "Bugs", Filter: domain.ViewFilter{Fields: map[string]string{"severity": "high"}}}, field: "fields.severity"},
+		{name: "unknown sort", view: domain.View{ID: "bugs", Name: "Bugs", Sort: "priority"}, field: "sort"},
+		{name: "invalid direction", view: domain.View{ID: "bugs", Name: "Bugs", Direction: "up"}, field: "direction"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockViewRepo{}
+			_, err := newViewUseCase(repo).Save(context.Background(), "board-1", &tt.view)
+			if tt.field == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(repo.views) != 1 {
+					t.Errorf("got %d stored views, want 1", len(repo.views))
+				}
+				return
+			}
+			var ve *domain.ErrValidation
+			if !errors.As(err, &ve) || ve.Field != tt.field {
+				t.Errorf("expected ErrValidation on %s, got %v", tt.field, err)
+			}
+		})
+	}
+}
+
+func TestViewUseCase_Cards(t *testing.T) {
+	repo := &mockViewRepo{views: []domain.View{{
+		ID:        "open-bugs",
+		Name:      "Open bugs",
+		Filter:    domain.ViewFilter{Labels: []string{"bug"}},
+		Lists:     []string{"todo"},
+		Sort:      "title",
+		Direction: "desc",
+	}}}
+	uc := newViewUseCase(repo)
+
+	page, err := uc.Cards(context.Background(), "board-1", "open-bugs", "", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Cards) != 1 || page.Cards[0].ID != "card-1" || page.NextCursor == "" {
+		t.Fatalf("first page = %+v", page)
+	}
+
+	page, err = uc.Cards(context.Background(), "board-1", "open-bugs", page.NextCursor, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Cards) != 1 || page.Cards[0].ID != "card-4" || page.NextCursor != "" {
+		t.Errorf("second page = %+v", page)
+	}
+
+	_, err = uc.Cards(context.Background(), "board-1", "missing", "", 0)
+	var notFound *domain.ErrNotFound
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestViewUseCase_Delete(t *testing.T) {
+	repo := &mockViewRepo{views: []domain.View{{ID: "bugs", Name: "Bugs"}}}
+	uc := newViewUseCase(repo)
+
+	if err := uc.Delete(context.Background(), "board-1", "bugs"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.views) != 0 {
+		t.Errorf("got %d views, want 0", len(repo.views))
+	}
+
+	err := uc.Delete(context.Background(), "board-missing", "bugs")
+	var notFound *domain.ErrNotFound
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
@@ -19,6 +19,7 @@ export interface Card {
   id: string
   title: string
   list: string
+  rank: string
   order: number
   description: string
   labels: string[]
@@ -36,7 +37,8 @@ export interface APIError {
 }
 
 export interface WSEvent {
-  type: 'board_updated' | 'card_updated'
+  type: 'board_updated' | 'card_updated' | 'comment_updated'
   board_id: string
+  card_id?: string
   timestamp: string
 }